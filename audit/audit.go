@@ -0,0 +1,191 @@
+// Package audit checks that a release is complete before it is made
+// available to the public.
+package audit
+
+import (
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/logger"
+)
+
+type Status string
+
+const (
+	StatusPass Status = "pass"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+)
+
+var statusRanks = map[Status]int{
+	StatusPass: 0,
+	StatusWarn: 1,
+	StatusFail: 2,
+}
+
+type Result struct {
+	Check   string `json:"check" yaml:"check"`
+	Status  Status `json:"status" yaml:"status"`
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+}
+
+type Report struct {
+	ProductSlug string   `json:"product_slug" yaml:"product_slug"`
+	Version     string   `json:"version" yaml:"version"`
+	Results     []Result `json:"results" yaml:"results"`
+}
+
+// Status returns the worst status of any check.
+func (r Report) Status() Status {
+	status := StatusPass
+	for _, result := range r.Results {
+		if statusRanks[result.Status] > statusRanks[status] {
+			status = result.Status
+		}
+	}
+	return status
+}
+
+func (r Report) Passed() bool {
+	return r.Status() != StatusFail
+}
+
+// Subject is everything known about the release being audited.
+type Subject struct {
+	ProductSlug           string
+	Release               pivnet.Release
+	ProductFiles          []pivnet.ProductFile
+	Dependencies          []pivnet.ReleaseDependency
+	DependencySpecifiers  []pivnet.DependencySpecifier
+	UpgradePaths          []pivnet.ReleaseUpgradePath
+	UpgradePathSpecifiers []pivnet.UpgradePathSpecifier
+}
+
+type Check interface {
+	Name() string
+	Run(subject Subject) (Status, string)
+}
+
+type checkFunc struct {
+	name string
+	run  func(Subject) (Status, string)
+}
+
+func (c checkFunc) Name() string {
+	return c.name
+}
+
+func (c checkFunc) Run(subject Subject) (Status, string) {
+	return c.run(subject)
+}
+
+// CheckFunc returns a Check with the given name that calls run.
+func CheckFunc(name string, run func(Subject) (Status, string)) Check {
+	return checkFunc{name: name, run: run}
+}
+
+type Options struct {
+	// RequireDependencies fails the audit when the release has no
+	// dependencies or dependency specifiers, rather than warning.
+	RequireDependencies bool
+
+	// RequireUpgradePaths fails the audit when the release has no
+	// upgrade paths or upgrade path specifiers, rather than warning.
+	RequireUpgradePaths bool
+
+	// Checks are run after the built-in checks.
+	Checks []Check
+}
+
+type Auditor struct {
+	client pivnet.Client
+	logger logger.Logger
+	checks []Check
+}
+
+func NewAuditor(client pivnet.Client, logger logger.Logger, options Options) Auditor {
+	return Auditor{
+		client: client,
+		logger: logger,
+		checks: append(builtInChecks(options), options.Checks...),
+	}
+}
+
+func (a Auditor) Audit(productSlug string, releaseID int) (Report, error) {
+	subject, err := a.subject(productSlug, releaseID)
+	if err != nil {
+		return Report{}, err
+	}
+
+	report := Report{
+		ProductSlug: productSlug,
+		Version:     subject.Release.Version,
+	}
+
+	for _, check := range a.checks {
+		status, message := check.Run(subject)
+
+		a.logger.Debug("Ran audit check", logger.Data{
+			"check":  check.Name(),
+			"status": status,
+		})
+
+		report.Results = append(report.Results, Result{
+			Check:   check.Name(),
+			Status:  status,
+			Message: message,
+		})
+	}
+
+	return report, nil
+}
+
+func (a Auditor) subject(productSlug string, releaseID int) (Subject, error) {
+	release, err := a.client.Releases.Get(productSlug, releaseID)
+	if err != nil {
+		return Subject{}, err
+	}
+
+	listed, err := a.client.ProductFiles.ListForRelease(productSlug, releaseID)
+	if err != nil {
+		return Subject{}, err
+	}
+
+	// Listed product files do not include every field, so fetch each one
+	var productFiles []pivnet.ProductFile
+	for _, productFile := range listed {
+		full, err := a.client.ProductFiles.GetForRelease(productSlug, releaseID, productFile.ID)
+		if err != nil {
+			return Subject{}, err
+		}
+		productFiles = append(productFiles, full)
+	}
+
+	dependencies, err := a.client.ReleaseDependencies.List(productSlug, releaseID)
+	if err != nil {
+		return Subject{}, err
+	}
+
+	dependencySpecifiers, err := a.client.DependencySpecifiers.List(productSlug, releaseID)
+	if err != nil {
+		return Subject{}, err
+	}
+
+	upgradePaths, err := a.client.ReleaseUpgradePaths.Get(productSlug, releaseID)
+	if err != nil {
+		return Subject{}, err
+	}
+
+	upgradePathSpecifiers, err := a.client.UpgradePathSpecifiers.List(productSlug, releaseID)
+	if err != nil {
+		return Subject{}, err
+	}
+
+	return Subject{
+		ProductSlug:           productSlug,
+		Release:               release,
+		ProductFiles:          productFiles,
+		Dependencies:          dependencies,
+		DependencySpecifiers:  dependencySpecifiers,
+		UpgradePaths:          upgradePaths,
+		UpgradePathSpecifiers: upgradePathSpecifiers,
+	}, nil
+}
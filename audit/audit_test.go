@@ -0,0 +1,238 @@
+package audit_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/onsi/gomega/ghttp"
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/audit"
+	"github.com/pivotal-cf/go-pivnet/logger/loggerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Auditor", func() {
+	var (
+		server  *ghttp.Server
+		client  pivnet.Client
+		options audit.Options
+
+		release      string
+		productFiles []string
+		dependencies string
+		upgradePaths string
+	)
+
+	releaseURL := func(suffix string) string {
+		return fmt.Sprintf("%s/products/some-product/releases/7%s", apiPrefix, suffix)
+	}
+
+	respond := func(body *string, format string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, format, *body)
+		}
+	}
+
+	resultFor := func(report audit.Report, check string) audit.Result {
+		for _, result := range report.Results {
+			if result.Check == check {
+				return result
+			}
+		}
+		Fail(fmt.Sprintf("no result for check '%s'", check))
+		return audit.Result{}
+	}
+
+	run := func() audit.Report {
+		var listed []string
+		for i, productFile := range productFiles {
+			id := i + 1
+			listed = append(listed, fmt.Sprintf(`{"id":%d}`, id))
+			server.RouteToHandler("GET", releaseURL(fmt.Sprintf("/product_files/%d", id)),
+				ghttp.RespondWith(http.StatusOK, fmt.Sprintf(`{"product_file":%s}`, productFile)))
+		}
+		server.RouteToHandler("GET", releaseURL("/product_files"),
+			ghttp.RespondWith(http.StatusOK, fmt.Sprintf(`{"product_files":[%s]}`, strings.Join(listed, ","))))
+
+		report, err := audit.NewAuditor(client, &loggerfakes.FakeLogger{}, options).Audit("some-product", 7)
+		Expect(err).NotTo(HaveOccurred())
+		return report
+	}
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+
+		client = pivnet.NewClient(pivnet.ClientConfig{
+			Host:      server.URL(),
+			Token:     "my-auth-token",
+			UserAgent: "pivnet-resource/0.1.0 (some-url)",
+		}, &loggerfakes.FakeLogger{})
+
+		options = audit.Options{}
+
+		release = `{
+			"id":7,
+			"version":"1.2.3",
+			"eula":{"slug":"some-eula"},
+			"oss_compliant":"confirm",
+			"release_notes_url":"https://example.com/notes",
+			"release_date":"2020-01-01",
+			"end_of_support_date":"2021-01-01",
+			"end_of_guidance_date":"2022-01-01"
+		}`
+		productFiles = []string{
+			`{"id":1,"name":"tile","file_type":"Software","sha256":"abc","ready_to_serve":true,"file_transfer_status":"complete"}`,
+			`{"id":2,"name":"osl","file_type":"Open Source License","sha256":"def","ready_to_serve":true}`,
+		}
+		dependencies = `{"release":{"id":9,"version":"2.0.0","product":{"slug":"other"}}}`
+		upgradePaths = `{"release":{"id":6,"version":"1.2.2"}}`
+
+		server.RouteToHandler("GET", releaseURL(""), respond(&release, "%s"))
+		server.RouteToHandler("GET", releaseURL("/dependencies"), respond(&dependencies, `{"dependencies":[%s]}`))
+		server.RouteToHandler("GET", releaseURL("/dependency_specifiers"),
+			ghttp.RespondWith(http.StatusOK, `{"dependency_specifiers":[]}`))
+		server.RouteToHandler("GET", releaseURL("/upgrade_paths"), respond(&upgradePaths, `{"upgrade_paths":[%s]}`))
+		server.RouteToHandler("GET", releaseURL("/upgrade_path_specifiers"),
+			ghttp.RespondWith(http.StatusOK, `{"upgrade_path_specifiers":[]}`))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("passes a complete release", func() {
+		report := run()
+
+		Expect(report.ProductSlug).To(Equal("some-product"))
+		Expect(report.Version).To(Equal("1.2.3"))
+		Expect(report.Results).To(HaveLen(8))
+		for _, result := range report.Results {
+			Expect(result.Status).To(Equal(audit.StatusPass), result.Check)
+		}
+		Expect(report.Status()).To(Equal(audit.StatusPass))
+		Expect(report.Passed()).To(BeTrue())
+	})
+
+	It("fails when the EULA or release notes URL are missing", func() {
+		release = `{"id":7,"version":"1.2.3","end_of_support_date":"2021-01-01"}`
+
+		report := run()
+
+		Expect(resultFor(report, audit.CheckEULA).Status).To(Equal(audit.StatusFail))
+		Expect(resultFor(report, audit.CheckReleaseNotesURL).Status).To(Equal(audit.StatusFail))
+		Expect(report.Passed()).To(BeFalse())
+	})
+
+	It("fails when product files are not ready", func() {
+		productFiles = []string{
+			`{"id":1,"name":"tile","file_type":"Open Source License","ready_to_serve":false}`,
+		}
+
+		result := resultFor(run(), audit.CheckProductFilesReady)
+		Expect(result.Status).To(Equal(audit.StatusFail))
+		Expect(result.Message).To(Equal("'tile' has no SHA256; 'tile' is not ready to serve"))
+	})
+
+	It("fails when a file transfer failed", func() {
+		productFiles = append(productFiles,
+			`{"id":3,"name":"broken","sha256":"123","ready_to_serve":true,"file_transfer_status":"failed"}`)
+
+		result := resultFor(run(), audit.CheckFileTransfers)
+		Expect(result.Status).To(Equal(audit.StatusFail))
+		Expect(result.Message).To(Equal("'broken' has file transfer status 'failed'"))
+	})
+
+	It("fails when an OSS compliant release has no open source license", func() {
+		productFiles = productFiles[:1]
+
+		Expect(resultFor(run(), audit.CheckOpenSourceLicense).Status).To(Equal(audit.StatusFail))
+	})
+
+	It("fails when end of support dates are inconsistent", func() {
+		release = `{
+			"id":7,
+			"version":"1.2.3",
+			"release_date":"2020-01-01",
+			"end_of_support_date":"2019-01-01",
+			"end_of_guidance_date":"2018-12-31",
+			"end_of_availability_date":"someday"
+		}`
+
+		result := resultFor(run(), audit.CheckEndOfSupportDates)
+		Expect(result.Status).To(Equal(audit.StatusFail))
+		Expect(result.Message).To(Equal(
+			"end of availability date 'someday' is not in the format YYYY-MM-DD; " +
+				"end of support date is before release date; " +
+				"end of guidance date is before release date; " +
+				"end of guidance date is before end of support date",
+		))
+	})
+
+	It("warns when there is no end of support date", func() {
+		release = `{"id":7,"version":"1.2.3"}`
+
+		Expect(resultFor(run(), audit.CheckEndOfSupportDates).Status).To(Equal(audit.StatusWarn))
+	})
+
+	Context("when there are no dependencies or upgrade paths", func() {
+		BeforeEach(func() {
+			dependencies = ""
+			upgradePaths = ""
+		})
+
+		It("warns", func() {
+			report := run()
+
+			Expect(resultFor(report, audit.CheckDependencies).Status).To(Equal(audit.StatusWarn))
+			Expect(resultFor(report, audit.CheckUpgradePaths).Status).To(Equal(audit.StatusWarn))
+			Expect(report.Status()).To(Equal(audit.StatusWarn))
+			Expect(report.Passed()).To(BeTrue())
+		})
+
+		It("fails when they are required", func() {
+			options.RequireDependencies = true
+			options.RequireUpgradePaths = true
+
+			report := run()
+
+			Expect(resultFor(report, audit.CheckDependencies).Status).To(Equal(audit.StatusFail))
+			Expect(resultFor(report, audit.CheckUpgradePaths).Status).To(Equal(audit.StatusFail))
+		})
+	})
+
+	It("runs custom checks after the built-in checks", func() {
+		options.Checks = []audit.Check{
+			audit.CheckFunc("has-description", func(s audit.Subject) (audit.Status, string) {
+				if s.Release.Description == "" {
+					return audit.StatusWarn, fmt.Sprintf("%s has no description", s.Release.Version)
+				}
+				return audit.StatusPass, ""
+			}),
+		}
+
+		report := run()
+
+		last := report.Results[len(report.Results)-1]
+		Expect(last).To(Equal(audit.Result{
+			Check:   "has-description",
+			Status:  audit.StatusWarn,
+			Message: "1.2.3 has no description",
+		}))
+	})
+
+	Context("when fetching the release fails", func() {
+		BeforeEach(func() {
+			server.RouteToHandler("GET", releaseURL(""),
+				ghttp.RespondWith(http.StatusTeapot, `{"message":"foo message"}`))
+		})
+
+		It("returns the error", func() {
+			_, err := audit.NewAuditor(client, &loggerfakes.FakeLogger{}, options).Audit("some-product", 7)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("foo message"))
+		})
+	})
+})
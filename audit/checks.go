@@ -0,0 +1,177 @@
+package audit
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet"
+)
+
+const (
+	CheckEULA              = "eula"
+	CheckProductFilesReady = "product-files-ready"
+	CheckFileTransfers     = "file-transfers"
+	CheckOpenSourceLicense = "open-source-license"
+	CheckReleaseNotesURL   = "release-notes-url"
+	CheckEndOfSupportDates = "end-of-support-dates"
+	CheckDependencies      = "dependencies"
+	CheckUpgradePaths      = "upgrade-paths"
+)
+
+const (
+	dateFormat            = "2006-01-02"
+	ossCompliantConfirmed = "confirm"
+)
+
+func builtInChecks(options Options) []Check {
+	return []Check{
+		CheckFunc(CheckEULA, checkEULA),
+		CheckFunc(CheckProductFilesReady, checkProductFilesReady),
+		CheckFunc(CheckFileTransfers, checkFileTransfers),
+		CheckFunc(CheckOpenSourceLicense, checkOpenSourceLicense),
+		CheckFunc(CheckReleaseNotesURL, checkReleaseNotesURL),
+		CheckFunc(CheckEndOfSupportDates, checkEndOfSupportDates),
+		CheckFunc(CheckDependencies, func(s Subject) (Status, string) {
+			if len(s.Dependencies)+len(s.DependencySpecifiers) > 0 {
+				return StatusPass, ""
+			}
+			return missing(options.RequireDependencies), "release has no dependencies or dependency specifiers"
+		}),
+		CheckFunc(CheckUpgradePaths, func(s Subject) (Status, string) {
+			if len(s.UpgradePaths)+len(s.UpgradePathSpecifiers) > 0 {
+				return StatusPass, ""
+			}
+			return missing(options.RequireUpgradePaths), "release has no upgrade paths or upgrade path specifiers"
+		}),
+	}
+}
+
+func missing(required bool) Status {
+	if required {
+		return StatusFail
+	}
+	return StatusWarn
+}
+
+func checkEULA(s Subject) (Status, string) {
+	if s.Release.EULA == nil || s.Release.EULA.Slug == "" {
+		return StatusFail, "release has no EULA"
+	}
+	return StatusPass, ""
+}
+
+func checkProductFilesReady(s Subject) (Status, string) {
+	if len(s.ProductFiles) == 0 {
+		return StatusWarn, "release has no product files"
+	}
+
+	var problems []string
+	for _, productFile := range s.ProductFiles {
+		if productFile.SHA256 == "" {
+			problems = append(problems, fmt.Sprintf("'%s' has no SHA256", productFile.Name))
+		}
+		if !productFile.ReadyToServe {
+			problems = append(problems, fmt.Sprintf("'%s' is not ready to serve", productFile.Name))
+		}
+	}
+
+	return failIf(problems)
+}
+
+func checkFileTransfers(s Subject) (Status, string) {
+	var problems []string
+	for _, productFile := range s.ProductFiles {
+		if productFile.TransferFailed() {
+			problems = append(problems, fmt.Sprintf(
+				"'%s' has file transfer status '%s'",
+				productFile.Name,
+				productFile.FileTransferStatus,
+			))
+		}
+	}
+
+	return failIf(problems)
+}
+
+func checkOpenSourceLicense(s Subject) (Status, string) {
+	if s.Release.OSSCompliant != ossCompliantConfirmed {
+		return StatusPass, ""
+	}
+
+	for _, productFile := range s.ProductFiles {
+		if productFile.FileType == pivnet.FileTypeOpenSourceLicense {
+			return StatusPass, ""
+		}
+	}
+
+	return StatusFail, fmt.Sprintf("release is OSS compliant but has no '%s' file", pivnet.FileTypeOpenSourceLicense)
+}
+
+func checkReleaseNotesURL(s Subject) (Status, string) {
+	if s.Release.ReleaseNotesURL == "" {
+		return StatusFail, "release has no release notes URL"
+	}
+	return StatusPass, ""
+}
+
+// checkEndOfSupportDates requires the release date to come no later than
+// any end date, and general support to end no later than technical
+// guidance.
+func checkEndOfSupportDates(s Subject) (Status, string) {
+	release := s.Release
+
+	if release.EndOfSupportDate == "" {
+		return StatusWarn, "release has no end of support date"
+	}
+
+	dates := map[string]time.Time{}
+	var problems []string
+	for _, d := range []struct{ name, value string }{
+		{"release date", release.ReleaseDate},
+		{"end of support date", release.EndOfSupportDate},
+		{"end of guidance date", release.EndOfGuidanceDate},
+		{"end of availability date", release.EndOfAvailabilityDate},
+	} {
+		name, value := d.name, d.value
+		if value == "" {
+			continue
+		}
+
+		date, err := time.Parse(dateFormat, value)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s '%s' is not in the format YYYY-MM-DD", name, value))
+			continue
+		}
+		dates[name] = date
+	}
+
+	before := func(earlier string, later string) {
+		e, ok := dates[earlier]
+		if !ok {
+			return
+		}
+		l, ok := dates[later]
+		if !ok {
+			return
+		}
+		if l.Before(e) {
+			problems = append(problems, fmt.Sprintf("%s is before %s", later, earlier))
+		}
+	}
+
+	before("release date", "end of support date")
+	before("release date", "end of guidance date")
+	before("release date", "end of availability date")
+	before("end of support date", "end of guidance date")
+
+	return failIf(problems)
+}
+
+func failIf(problems []string) (Status, string) {
+	if len(problems) == 0 {
+		return StatusPass, ""
+	}
+
+	return StatusFail, strings.Join(problems, "; ")
+}
@@ -1,6 +1,9 @@
 package pivnet
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+)
 
 type AuthService struct {
 	client Client
@@ -12,9 +15,17 @@ type AuthService struct {
 // false,err if the auth attempt failed for any other reason.
 // It is guaranteed never to return true,err.
 func (e AuthService) Check() (bool, error) {
+	return e.CheckWithContext(context.Background())
+}
+
+func (e AuthService) CheckWithContext(ctx context.Context) (bool, error) {
+	ctx, span := e.client.startSpan(ctx, "Auth.Check")
+	defer span.End()
+
 	url := "/authentication"
 
-	resp, err := e.client.MakeRequest(
+	resp, err := e.client.MakeRequestWithContext(
+		ctx,
 		"GET",
 		url,
 		0,
@@ -0,0 +1,244 @@
+// Package cache caches responses to API GET requests, revalidating them
+// with If-None-Match and If-Modified-Since.
+//
+// Responses with Cache-Control no-store or private, or Vary *, are not
+// cached. Requests already carrying If-None-Match or If-Modified-Since
+// are passed through, so the caller receives any 304 Not Modified.
+//
+// Add it to the client with pivnet.ClientConfig.Middleware:
+//
+//	config.Middleware = append(config.Middleware, cache.Middleware(cache.Config{
+//		Store: cache.NewMemoryStore(),
+//	}))
+package cache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet/logger"
+)
+
+// StatusHeader is set on responses served from the cache.
+const StatusHeader = "X-Pivnet-Cache"
+
+const (
+	// StatusRevalidated means the server answered 304 Not Modified.
+	StatusRevalidated = "revalidated"
+
+	// StatusOffline means the server could not be reached.
+	StatusOffline = "offline"
+)
+
+type Config struct {
+	Store Store
+
+	// OfflineTTL is how old a cached response may be and still be served
+	// when the server cannot be reached or answers 502, 503 or 504. Zero
+	// disables the offline mode.
+	OfflineTTL time.Duration
+
+	// Logger is warned when the store cannot be read or written.
+	Logger logger.Logger
+}
+
+// Middleware returns a pivnet.Middleware caching responses.
+func Middleware(config Config) func(http.RoundTripper) http.RoundTripper {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return &Transport{Config: config, Next: next}
+	}
+}
+
+type Transport struct {
+	Config Config
+	Next   http.RoundTripper
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Range requests are product file downloads
+	if req.Method != "GET" || req.Header.Get("Range") != "" || hasDirective(req.Header, "no-store") {
+		return t.Next.RoundTrip(req)
+	}
+
+	// The caller is revalidating its own copy, so must see a 304
+	if conditional(req) {
+		return t.Next.RoundTrip(req)
+	}
+
+	key := Key(req)
+
+	entry, cached, err := t.Config.Store.Get(key)
+	if err != nil {
+		t.warn("Could not read cache entry", key, err)
+		cached = false
+	}
+	cached = cached && entry.matches(req)
+
+	if cached {
+		req = req.Clone(req.Context())
+		if etag := entry.ETag(); etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if lastModified := entry.LastModified(); lastModified != "" {
+			req.Header.Set("If-Modified-Since", lastModified)
+		}
+	}
+
+	resp, err := t.Next.RoundTrip(req)
+	if err != nil {
+		if cached && t.servesOffline(entry) {
+			return response(req, entry, StatusOffline), nil
+		}
+		return nil, err
+	}
+
+	switch {
+	case cached && resp.StatusCode == http.StatusNotModified:
+		resp.Body.Close()
+
+		entry.StoredAt = time.Now()
+		t.set(key, entry)
+
+		return response(req, entry, StatusRevalidated), nil
+
+	case cached && unavailable(resp.StatusCode) && t.servesOffline(entry):
+		resp.Body.Close()
+
+		return response(req, entry, StatusOffline), nil
+
+	case resp.StatusCode == http.StatusOK && cacheable(resp):
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		t.set(key, Entry{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header.Clone(),
+			Body:       body,
+			Vary:       varyHeader(req, resp),
+			StoredAt:   time.Now(),
+		})
+	}
+
+	return resp, nil
+}
+
+func (t *Transport) set(key string, entry Entry) {
+	err := t.Config.Store.Set(key, entry)
+	if err != nil {
+		t.warn("Could not write cache entry", key, err)
+	}
+}
+
+func (t *Transport) warn(action string, key string, err error) {
+	if t.Config.Logger == nil {
+		return
+	}
+
+	logger.Warn(t.Config.Logger, action, logger.Data{"key": key, "error": err.Error()})
+}
+
+func (t *Transport) servesOffline(entry Entry) bool {
+	return t.Config.OfflineTTL > 0 && time.Since(entry.StoredAt) <= t.Config.OfflineTTL
+}
+
+// Key identifies the cached response to a request. It includes a hash of
+// the Authorization header, as users may see different responses.
+func Key(req *http.Request) string {
+	sum := sha256.Sum256([]byte(req.Header.Get("Authorization")))
+	return hex.EncodeToString(sum[:8]) + " " + req.URL.String()
+}
+
+func cacheable(resp *http.Response) bool {
+	if hasDirective(resp.Header, "no-store") || hasDirective(resp.Header, "private") {
+		return false
+	}
+
+	for _, name := range varyNames(resp.Header) {
+		if name == "*" {
+			return false
+		}
+	}
+
+	return resp.Header.Get("ETag") != "" || resp.Header.Get("Last-Modified") != ""
+}
+
+func conditional(req *http.Request) bool {
+	return req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != ""
+}
+
+// hasDirective reports whether the Cache-Control header has directive.
+func hasDirective(header http.Header, directive string) bool {
+	for _, value := range header.Values("Cache-Control") {
+		for _, d := range strings.Split(value, ",") {
+			name := strings.TrimSpace(strings.SplitN(d, "=", 2)[0])
+			if strings.EqualFold(name, directive) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func varyNames(header http.Header) []string {
+	var names []string
+	for _, value := range header.Values("Vary") {
+		for _, name := range strings.Split(value, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, http.CanonicalHeaderKey(name))
+			}
+		}
+	}
+	return names
+}
+
+// varyHeader returns the request headers named by the Vary header of
+// resp, which must match for the response to be reused.
+func varyHeader(req *http.Request, resp *http.Response) http.Header {
+	names := varyNames(resp.Header)
+	if len(names) == 0 {
+		return nil
+	}
+
+	header := http.Header{}
+	for _, name := range names {
+		header[name] = req.Header.Values(name)
+	}
+	return header
+}
+
+func unavailable(statusCode int) bool {
+	switch statusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+func response(req *http.Request, entry Entry, status string) *http.Response {
+	header := entry.Header.Clone()
+	header.Set(StatusHeader, status)
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", entry.StatusCode, http.StatusText(entry.StatusCode)),
+		StatusCode:    entry.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(entry.Body)),
+		ContentLength: int64(len(entry.Body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,242 @@
+package cache_test
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/onsi/gomega/ghttp"
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/cache"
+	"github.com/pivotal-cf/go-pivnet/logger/loggerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cache", func() {
+	var (
+		server *ghttp.Server
+		store  *cache.MemoryStore
+
+		requests []*http.Request
+		status   int
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		store = cache.NewMemoryStore()
+		requests = nil
+		status = http.StatusOK
+
+		server.RouteToHandler("GET", apiPrefix+"/products", func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r)
+
+			if status != http.StatusOK {
+				w.WriteHeader(status)
+				return
+			}
+
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+
+			w.Header().Set("ETag", `"v1"`)
+			w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+			w.Write([]byte(`{"products":[{"id":1,"slug":"some-product"}]}`))
+		})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newClient := func(token string, config cache.Config) pivnet.Client {
+		config.Store = store
+		return pivnet.NewClient(pivnet.ClientConfig{
+			Host:       server.URL(),
+			Token:      token,
+			Middleware: []pivnet.Middleware{cache.Middleware(config)},
+		}, &loggerfakes.FakeLogger{})
+	}
+
+	listProducts := func(client pivnet.Client) []pivnet.Product {
+		products, err := client.Products.List()
+		Expect(err).NotTo(HaveOccurred())
+		return products
+	}
+
+	It("sends conditional requests and serves 304 responses from the cache", func() {
+		client := newClient("my-auth-token", cache.Config{})
+
+		Expect(listProducts(client)).To(Equal([]pivnet.Product{{ID: 1, Slug: "some-product"}}))
+		Expect(listProducts(client)).To(Equal([]pivnet.Product{{ID: 1, Slug: "some-product"}}))
+
+		Expect(requests).To(HaveLen(2))
+		Expect(requests[0].Header.Get("If-None-Match")).To(BeEmpty())
+		Expect(requests[1].Header.Get("If-None-Match")).To(Equal(`"v1"`))
+		Expect(requests[1].Header.Get("If-Modified-Since")).To(Equal("Mon, 02 Jan 2006 15:04:05 GMT"))
+	})
+
+	It("does not share responses between tokens", func() {
+		listProducts(newClient("my-auth-token", cache.Config{}))
+		listProducts(newClient("other-auth-token", cache.Config{}))
+
+		Expect(requests[1].Header.Get("If-None-Match")).To(BeEmpty())
+	})
+
+	It("does not cache responses without an ETag or Last-Modified", func() {
+		server.RouteToHandler("GET", apiPrefix+"/products/some-product", func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r)
+			w.Write([]byte(`{"id":1,"slug":"some-product"}`))
+		})
+		client := newClient("my-auth-token", cache.Config{})
+
+		for i := 0; i < 2; i++ {
+			_, err := client.Products.Get("some-product")
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		Expect(requests[1].Header.Get("If-None-Match")).To(BeEmpty())
+		Expect(requests[1].Header.Get("If-Modified-Since")).To(BeEmpty())
+	})
+
+	It("passes 304 responses through when the caller sends its own conditional request", func() {
+		client := &http.Client{Transport: &cache.Transport{Config: cache.Config{Store: store}, Next: http.DefaultTransport}}
+
+		req, err := http.NewRequest("GET", server.URL()+apiPrefix+"/products", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		resp, err := client.Do(req)
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+
+		req.Header.Set("If-None-Match", `"v1"`)
+
+		resp, err = client.Do(req)
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+
+		Expect(resp.StatusCode).To(Equal(http.StatusNotModified))
+		Expect(resp.Header.Get(cache.StatusHeader)).To(BeEmpty())
+	})
+
+	It("does not cache responses with Cache-Control no-store or private", func() {
+		for _, directive := range []string{"no-store", "private, max-age=60"} {
+			server.RouteToHandler("GET", apiPrefix+"/products/some-product", func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r)
+				w.Header().Set("ETag", `"v1"`)
+				w.Header().Set("Cache-Control", directive)
+				w.Write([]byte(`{"id":1,"slug":"some-product"}`))
+			})
+			requests = nil
+			client := newClient("my-auth-token", cache.Config{})
+
+			for i := 0; i < 2; i++ {
+				_, err := client.Products.Get("some-product")
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			Expect(requests[1].Header.Get("If-None-Match")).To(BeEmpty(), directive)
+		}
+	})
+
+	It("only reuses responses for requests matching their Vary header", func() {
+		server.RouteToHandler("GET", apiPrefix+"/products/some-product", func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r)
+			w.Header().Set("ETag", `"v1"`)
+			w.Header().Set("Vary", "Accept-Language")
+			w.Write([]byte(`{"id":1,"slug":"some-product"}`))
+		})
+		client := &http.Client{Transport: &cache.Transport{Config: cache.Config{Store: store}, Next: http.DefaultTransport}}
+
+		get := func(language string) {
+			req, err := http.NewRequest("GET", server.URL()+apiPrefix+"/products/some-product", nil)
+			Expect(err).NotTo(HaveOccurred())
+			req.Header.Set("Accept-Language", language)
+
+			resp, err := client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+			resp.Body.Close()
+		}
+
+		get("en")
+		get("de")
+		get("de")
+
+		Expect(requests).To(HaveLen(3))
+		Expect(requests[1].Header.Get("If-None-Match")).To(BeEmpty())
+		Expect(requests[2].Header.Get("If-None-Match")).To(Equal(`"v1"`))
+	})
+
+	It("logs a warning when the store cannot be written", func() {
+		fakeLogger := &loggerfakes.FakeLeveledLogger{}
+		client := &http.Client{Transport: &cache.Transport{
+			Config: cache.Config{Store: failingStore{}, Logger: fakeLogger},
+			Next:   http.DefaultTransport,
+		}}
+
+		resp, err := client.Get(server.URL() + apiPrefix + "/products")
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(fakeLogger.WarnCallCount()).To(Equal(1))
+
+		action, data := fakeLogger.WarnArgsForCall(0)
+		Expect(action).To(Equal("Could not write cache entry"))
+		Expect(data[0]["error"]).To(Equal("disk full"))
+	})
+
+	Describe("offline mode", func() {
+		It("serves cached responses when the server cannot be reached", func() {
+			client := newClient("my-auth-token", cache.Config{OfflineTTL: time.Hour})
+			listProducts(client)
+
+			server.Close()
+
+			Expect(listProducts(client)).To(Equal([]pivnet.Product{{ID: 1, Slug: "some-product"}}))
+		})
+
+		It("serves cached responses when the server is unavailable", func() {
+			client := newClient("my-auth-token", cache.Config{OfflineTTL: time.Hour})
+			listProducts(client)
+
+			status = http.StatusServiceUnavailable
+
+			Expect(listProducts(client)).To(Equal([]pivnet.Product{{ID: 1, Slug: "some-product"}}))
+		})
+
+		It("returns the error when cached responses are older than the TTL", func() {
+			client := newClient("my-auth-token", cache.Config{OfflineTTL: time.Nanosecond})
+			listProducts(client)
+
+			server.Close()
+			time.Sleep(time.Millisecond)
+
+			_, err := client.Products.List()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("is disabled by default", func() {
+			client := newClient("my-auth-token", cache.Config{})
+			listProducts(client)
+
+			status = http.StatusServiceUnavailable
+
+			_, err := client.Products.List()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
+
+type failingStore struct{}
+
+func (failingStore) Get(key string) (cache.Entry, bool, error) {
+	return cache.Entry{}, false, nil
+}
+
+func (failingStore) Set(key string, entry cache.Entry) error {
+	return errors.New("disk full")
+}
@@ -0,0 +1,139 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Entry is a cached response.
+type Entry struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+
+	// Vary holds the request headers named by the Vary response header.
+	Vary http.Header `json:"vary,omitempty"`
+
+	// StoredAt is when the response was last received or revalidated.
+	StoredAt time.Time `json:"stored_at"`
+}
+
+func (e Entry) ETag() string {
+	return e.Header.Get("ETag")
+}
+
+func (e Entry) LastModified() string {
+	return e.Header.Get("Last-Modified")
+}
+
+// matches reports whether req has the request headers the entry varies
+// on.
+func (e Entry) matches(req *http.Request) bool {
+	for name, values := range e.Vary {
+		if strings.Join(req.Header.Values(name), ", ") != strings.Join(values, ", ") {
+			return false
+		}
+	}
+	return true
+}
+
+type Store interface {
+	Get(key string) (Entry, bool, error)
+	Set(key string, entry Entry) error
+}
+
+type MemoryStore struct {
+	mutex   sync.RWMutex
+	entries map[string]Entry
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: map[string]Entry{}}
+}
+
+func (s *MemoryStore) Get(key string) (Entry, bool, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	entry, ok := s.entries[key]
+	return entry, ok, nil
+}
+
+func (s *MemoryStore) Set(key string, entry Entry) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.entries[key] = entry
+	return nil
+}
+
+// DiskStore keeps each entry as a JSON file in a directory, so the cache
+// survives restarts.
+type DiskStore struct {
+	dir string
+}
+
+func NewDiskStore(dir string) (*DiskStore, error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, fmt.Errorf("could not create cache directory: %s", err)
+	}
+
+	return &DiskStore{dir: dir}, nil
+}
+
+func (s *DiskStore) Get(key string) (Entry, bool, error) {
+	b, err := ioutil.ReadFile(s.path(key))
+	if os.IsNotExist(err) {
+		return Entry{}, false, nil
+	}
+	if err != nil {
+		return Entry{}, false, err
+	}
+
+	var entry Entry
+	err = json.Unmarshal(b, &entry)
+	if err != nil {
+		return Entry{}, false, fmt.Errorf("could not parse cache entry: %s", err)
+	}
+
+	return entry, true, nil
+}
+
+func (s *DiskStore) Set(key string, entry Entry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so readers never see a partial entry
+	f, err := ioutil.TempFile(s.dir, ".entry")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.Write(b)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), s.path(key))
+}
+
+func (s *DiskStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:])+".json")
+}
@@ -0,0 +1,58 @@
+package cache_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet/cache"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DiskStore", func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "cache")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("keeps entries across instances", func() {
+		entry := cache.Entry{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Etag": {`"v1"`}},
+			Body:       []byte(`{"products":[]}`),
+			StoredAt:   time.Now().UTC().Round(time.Second),
+		}
+
+		store, err := cache.NewDiskStore(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(store.Set("some-key", entry)).To(Succeed())
+
+		store, err = cache.NewDiskStore(dir)
+		Expect(err).NotTo(HaveOccurred())
+
+		stored, ok, err := store.Get("some-key")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeTrue())
+		Expect(stored).To(Equal(entry))
+		Expect(stored.ETag()).To(Equal(`"v1"`))
+	})
+
+	It("reports missing entries", func() {
+		store, err := cache.NewDiskStore(dir)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, ok, err := store.Get("some-key")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeFalse())
+	})
+})
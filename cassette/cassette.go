@@ -0,0 +1,215 @@
+// Package cassette records HTTP interactions with Pivotal Network to a
+// file and replays them, so suites can run without network access.
+//
+// Use a Recorder as the client transport:
+//
+//	recorder, err := cassette.New("fixtures/releases.yml", cassette.Config{
+//		Mode:  cassette.ModeReplay,
+//		Token: token,
+//	})
+//	client := pivnet.NewClient(pivnet.ClientConfig{
+//		Host:      host,
+//		Token:     token,
+//		Transport: recorder,
+//	}, logger)
+//	defer recorder.Stop()
+//
+// Recorded interactions hold neither the token, the tokens exchanged with
+// the authentication endpoints, nor the signatures of download URLs.
+// Binary bodies, such as product file downloads, are recorded by size
+// only, and replayed as zeros marked with download.SyntheticBodyHeader so
+// their checksums are not verified.
+package cassette
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	SanitizedToken  = "***sanitized-api-token***"
+	SanitizedSecret = "***sanitized***"
+)
+
+// Cassette is the recorded form of a sequence of interactions.
+type Cassette struct {
+	Interactions []Interaction `yaml:"interactions"`
+}
+
+type Interaction struct {
+	Request  Request  `yaml:"request"`
+	Response Response `yaml:"response"`
+}
+
+type Request struct {
+	Method  string      `yaml:"method"`
+	URL     string      `yaml:"url"`
+	Headers http.Header `yaml:"headers,omitempty"`
+	Body    string      `yaml:"body,omitempty"`
+}
+
+type Response struct {
+	StatusCode int         `yaml:"status_code"`
+	Headers    http.Header `yaml:"headers,omitempty"`
+	Body       string      `yaml:"body,omitempty"`
+
+	// OmittedBodySize is the size of a binary body, such as a product
+	// file download, that was not recorded. It is replayed as zeros.
+	OmittedBodySize int64 `yaml:"omitted_body_size,omitempty"`
+}
+
+// Load reads a cassette written by Write.
+func Load(r io.Reader) (Cassette, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return Cassette{}, err
+	}
+
+	var c Cassette
+	err = yaml.Unmarshal(b, &c)
+	if err != nil {
+		return Cassette{}, fmt.Errorf("could not parse cassette: %s", err)
+	}
+
+	return c, nil
+}
+
+func LoadFile(path string) (Cassette, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Cassette{}, err
+	}
+	defer file.Close()
+
+	return Load(file)
+}
+
+func (c Cassette) Write(w io.Writer) error {
+	b, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(b)
+	return err
+}
+
+func (c Cassette) WriteFile(path string) error {
+	var buf bytes.Buffer
+	err := c.Write(&buf)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, buf.Bytes(), 0644)
+}
+
+// Headers that identify the caller are never recorded.
+var sensitiveHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// JSON fields holding the tokens exchanged with the authentication
+// endpoints.
+var secretFields = regexp.MustCompile(`("(?:access_token|refresh_token|id_token)"\s*:\s*)"[^"]*"`)
+
+// Query parameters signing the download URLs Pivnet redirects to.
+var signatureParams = []string{
+	"Signature",
+	"Policy",
+	"Key-Pair-Id",
+	"X-Amz-Signature",
+	"X-Amz-Credential",
+	"X-Amz-Security-Token",
+}
+
+// sanitize removes sensitive headers, every occurrence of token, the
+// tokens in authentication requests and responses, and the signatures of
+// download URLs.
+func (i Interaction) sanitize(token string) Interaction {
+	replace := func(s string) string {
+		if token != "" {
+			s = strings.Replace(s, token, SanitizedToken, -1)
+		}
+		return secretFields.ReplaceAllString(s, `$1"`+SanitizedSecret+`"`)
+	}
+
+	headers := func(h http.Header) http.Header {
+		if len(h) == 0 {
+			return nil
+		}
+
+		sanitized := http.Header{}
+		for name, values := range h {
+			for _, value := range values {
+				sanitized.Add(name, replace(value))
+			}
+		}
+		for _, name := range sensitiveHeaders {
+			sanitized.Del(name)
+		}
+		if location := sanitized.Get("Location"); location != "" {
+			sanitized.Set("Location", sanitizeURL(location))
+		}
+		return sanitized
+	}
+
+	i.Request.URL = sanitizeURL(replace(i.Request.URL))
+	i.Request.Headers = headers(i.Request.Headers)
+	i.Request.Body = replace(i.Request.Body)
+	i.Response.Headers = headers(i.Response.Headers)
+	i.Response.Body = replace(i.Response.Body)
+
+	return i
+}
+
+// sanitizeURL replaces the values of signature query parameters.
+func sanitizeURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.RawQuery == "" {
+		return rawURL
+	}
+
+	query := u.Query()
+	sanitized := false
+	for _, param := range signatureParams {
+		if _, ok := query[param]; ok {
+			query.Set(param, SanitizedSecret)
+			sanitized = true
+		}
+	}
+	if !sanitized {
+		return rawURL
+	}
+
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// isText reports whether a body of the given content type is recorded.
+// Other bodies, such as product file downloads, are omitted.
+func isText(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return strings.HasPrefix(mediaType, "text/") ||
+		mediaType == "application/json" ||
+		strings.HasSuffix(mediaType, "+json") ||
+		mediaType == "application/xml" ||
+		strings.HasSuffix(mediaType, "+xml") ||
+		mediaType == "application/x-yaml"
+}
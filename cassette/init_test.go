@@ -0,0 +1,15 @@
+package cassette_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+const apiPrefix = "/api/v2"
+
+func TestCassette(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Cassette Suite")
+}
@@ -0,0 +1,331 @@
+package cassette
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+
+	"github.com/pivotal-cf/go-pivnet/download"
+)
+
+type Mode string
+
+const (
+	// ModeRecord sends requests and records them, writing the cassette
+	// on Stop.
+	ModeRecord Mode = "record"
+
+	// ModeReplay answers requests from the cassette without sending them.
+	ModeReplay Mode = "replay"
+)
+
+// Matcher reports whether a request matches a recorded one. body is the
+// sanitized request body.
+type Matcher func(r *http.Request, body string, recorded Request) bool
+
+func MatchMethod(r *http.Request, body string, recorded Request) bool {
+	return r.Method == recorded.Method
+}
+
+func MatchPath(r *http.Request, body string, recorded Request) bool {
+	u, err := url.Parse(recorded.URL)
+	return err == nil && u.Path == r.URL.Path
+}
+
+func MatchQuery(r *http.Request, body string, recorded Request) bool {
+	u, err := url.Parse(recorded.URL)
+	return err == nil && u.Query().Encode() == r.URL.Query().Encode()
+}
+
+func MatchBody(r *http.Request, body string, recorded Request) bool {
+	return body == recorded.Body
+}
+
+// MatchHeader matches requests whose named header equals the recorded
+// one, for example Range on product file downloads.
+func MatchHeader(name string) Matcher {
+	return func(r *http.Request, body string, recorded Request) bool {
+		return r.Header.Get(name) == recorded.Headers.Get(name)
+	}
+}
+
+var DefaultMatchers = []Matcher{MatchMethod, MatchPath, MatchHeader("Range")}
+
+type Config struct {
+	Mode Mode
+
+	// Token is replaced with SanitizedToken wherever it appears in a
+	// recorded interaction.
+	Token string
+
+	// Matchers must all match for a recorded interaction to be replayed.
+	// Defaults to DefaultMatchers.
+	Matchers []Matcher
+
+	// Transport sends requests in ModeRecord. Defaults to
+	// http.DefaultTransport.
+	Transport http.RoundTripper
+}
+
+// Recorder is an http.RoundTripper that records or replays interactions.
+//
+// When replaying, each recorded interaction is used at most once, and
+// requests are answered by the first unused interaction that matches.
+type Recorder struct {
+	path   string
+	config Config
+
+	mutex    sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// New returns a recorder for the cassette at path, which must exist in
+// ModeReplay.
+func New(path string, config Config) (*Recorder, error) {
+	if config.Matchers == nil {
+		config.Matchers = DefaultMatchers
+	}
+	if config.Transport == nil {
+		config.Transport = http.DefaultTransport
+	}
+
+	r := &Recorder{
+		path:   path,
+		config: config,
+	}
+
+	switch config.Mode {
+	case ModeRecord:
+	case ModeReplay:
+		c, err := LoadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		r.cassette = c
+		r.used = make([]bool, len(c.Interactions))
+	default:
+		return nil, fmt.Errorf("invalid cassette mode '%s'", config.Mode)
+	}
+
+	return r, nil
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	req, body, err := requestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.config.Mode == ModeReplay {
+		return r.replay(req, body)
+	}
+
+	return r.record(req, body)
+}
+
+// Stop writes the cassette when recording. It does nothing when
+// replaying.
+func (r *Recorder) Stop() error {
+	if r.config.Mode != ModeRecord {
+		return nil
+	}
+
+	return r.Cassette().WriteFile(r.path)
+}
+
+// Cassette returns the interactions recorded or loaded so far.
+func (r *Recorder) Cassette() Cassette {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return Cassette{
+		Interactions: append([]Interaction{}, r.cassette.Interactions...),
+	}
+}
+
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	resp, err := r.config.Transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	binary := !isText(resp.Header.Get("Content-Type"))
+
+	var respBody []byte
+	if !binary {
+		respBody, err = ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+	}
+
+	interaction := Interaction{
+		Request: Request{
+			Method:  req.Method,
+			URL:     req.URL.String(),
+			Headers: req.Header,
+			Body:    string(body),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    resp.Header,
+			Body:       string(respBody),
+		},
+	}.sanitize(r.config.Token)
+
+	r.mutex.Lock()
+	i := len(r.cassette.Interactions)
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.mutex.Unlock()
+
+	// Binary bodies are passed through and only their size is recorded
+	if binary {
+		resp.Body = &countingBody{
+			ReadCloser: resp.Body,
+			closed: func(size int64) {
+				r.mutex.Lock()
+				r.cassette.Interactions[i].Response.OmittedBodySize = size
+				r.mutex.Unlock()
+			},
+		}
+	}
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	sanitizedBody := Interaction{
+		Request: Request{Body: string(body)},
+	}.sanitize(r.config.Token).Request.Body
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || !r.matches(req, sanitizedBody, interaction.Request) {
+			continue
+		}
+
+		r.used[i] = true
+
+		header := http.Header{}
+		for name, values := range interaction.Response.Headers {
+			header[name] = append([]string{}, values...)
+		}
+
+		var body io.Reader = bytes.NewBufferString(interaction.Response.Body)
+		contentLength := int64(len(interaction.Response.Body))
+		if interaction.Response.OmittedBodySize > 0 {
+			body = io.LimitReader(zeros{}, interaction.Response.OmittedBodySize)
+			contentLength = interaction.Response.OmittedBodySize
+		}
+
+		// Responses to HEAD requests have a length but no body
+		if req.Method == "HEAD" {
+			contentLength, _ = strconv.ParseInt(header.Get("Content-Length"), 10, 64)
+		}
+
+		// Replayed binary bodies are zeros, so must not be checked against
+		// the checksum of the file
+		if !isText(header.Get("Content-Type")) {
+			header.Set(download.SyntheticBodyHeader, "true")
+		}
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          ioutil.NopCloser(body),
+			ContentLength: contentLength,
+			Request:       req,
+		}, nil
+	}
+
+	return nil, ErrNoInteraction{Method: req.Method, URL: req.URL.String()}
+}
+
+func (r *Recorder) matches(req *http.Request, body string, recorded Request) bool {
+	for _, matcher := range r.config.Matchers {
+		if !matcher(req, body, recorded) {
+			return false
+		}
+	}
+	return true
+}
+
+// requestBody reads the body of req without consuming it. A request that
+// cannot recreate its body is cloned with a copy of the body.
+func requestBody(req *http.Request) (*http.Request, []byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil, nil
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, nil, err
+		}
+		defer body.Close()
+
+		b, err := ioutil.ReadAll(body)
+		return req, b, err
+	}
+
+	b, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req = req.Clone(req.Context())
+	req.Body = ioutil.NopCloser(bytes.NewReader(b))
+	return req, b, nil
+}
+
+// countingBody reports the number of bytes read when it is closed.
+type countingBody struct {
+	io.ReadCloser
+	size   int64
+	closed func(size int64)
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.size += int64(n)
+	return n, err
+}
+
+func (b *countingBody) Close() error {
+	b.closed(b.size)
+	return b.ReadCloser.Close()
+}
+
+type zeros struct{}
+
+func (zeros) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
+
+type ErrNoInteraction struct {
+	Method string
+	URL    string
+}
+
+func (e ErrNoInteraction) Error() string {
+	return fmt.Sprintf("no recorded interaction matches %s %s", e.Method, e.URL)
+}
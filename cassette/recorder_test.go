@@ -0,0 +1,323 @@
+package cassette_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/onsi/gomega/ghttp"
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/cassette"
+	"github.com/pivotal-cf/go-pivnet/logger/loggerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Recorder", func() {
+	const token = "some-secret-token"
+
+	var (
+		server *ghttp.Server
+		dir    string
+		path   string
+	)
+
+	newClient := func(host string, recorder *cassette.Recorder) pivnet.Client {
+		return pivnet.NewClient(pivnet.ClientConfig{
+			Host:      host,
+			Token:     token,
+			UserAgent: "go-pivnet/cassette-test",
+			Transport: recorder,
+		}, &loggerfakes.FakeLogger{})
+	}
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+
+		var err error
+		dir, err = ioutil.TempDir("", "cassette")
+		Expect(err).NotTo(HaveOccurred())
+		path = filepath.Join(dir, "cassette.yml")
+
+		server.RouteToHandler("GET", apiPrefix+"/products/some-product/releases",
+			ghttp.RespondWith(http.StatusOK, `{"releases":[{"id":1234,"version":"1.2.3","description":"built with some-secret-token"}]}`))
+		server.RouteToHandler("POST", apiPrefix+"/products/some-product/releases",
+			ghttp.RespondWith(http.StatusCreated, `{"release":{"id":5678,"version":"2.0.0"}}`))
+	})
+
+	AfterEach(func() {
+		server.Close()
+		os.RemoveAll(dir)
+	})
+
+	record := func() {
+		recorder, err := cassette.New(path, cassette.Config{Mode: cassette.ModeRecord, Token: token})
+		Expect(err).NotTo(HaveOccurred())
+
+		client := newClient(server.URL(), recorder)
+
+		_, err = client.Releases.List("some-product")
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = client.Releases.Create(pivnet.CreateReleaseConfig{
+			ProductSlug: "some-product",
+			Version:     "2.0.0",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(recorder.Stop()).To(Succeed())
+	}
+
+	It("records sanitized interactions", func() {
+		record()
+
+		contents, err := ioutil.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(contents)).NotTo(ContainSubstring(token))
+		Expect(string(contents)).To(ContainSubstring(cassette.SanitizedToken))
+
+		c, err := cassette.LoadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Interactions).To(HaveLen(2))
+		Expect(c.Interactions[0].Request.Method).To(Equal("GET"))
+		Expect(c.Interactions[0].Request.Headers.Get("Authorization")).To(BeEmpty())
+		Expect(c.Interactions[0].Request.Headers.Get("User-Agent")).To(Equal("go-pivnet/cassette-test"))
+		Expect(c.Interactions[1].Response.StatusCode).To(Equal(http.StatusCreated))
+		Expect(c.Interactions[1].Request.Body).To(ContainSubstring(`"version":"2.0.0"`))
+	})
+
+	It("replays interactions without sending requests", func() {
+		record()
+		server.Close()
+
+		recorder, err := cassette.New(path, cassette.Config{Mode: cassette.ModeReplay, Token: token})
+		Expect(err).NotTo(HaveOccurred())
+
+		client := newClient("https://replayed.example.com", recorder)
+
+		release, err := client.Releases.Create(pivnet.CreateReleaseConfig{
+			ProductSlug: "some-product",
+			Version:     "2.0.0",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(release.ID).To(Equal(5678))
+
+		releases, err := client.Releases.List("some-product")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(releases).To(HaveLen(1))
+		Expect(releases[0].Description).To(Equal("built with " + cassette.SanitizedToken))
+
+		_, err = client.Releases.List("some-product")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("no recorded interaction matches GET"))
+	})
+
+	It("matches on the configured fields", func() {
+		record()
+
+		recorder, err := cassette.New(path, cassette.Config{
+			Mode:     cassette.ModeReplay,
+			Token:    token,
+			Matchers: []cassette.Matcher{cassette.MatchMethod, cassette.MatchPath, cassette.MatchBody},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		client := newClient(server.URL(), recorder)
+
+		_, err = client.Releases.Create(pivnet.CreateReleaseConfig{
+			ProductSlug: "some-product",
+			Version:     "3.0.0",
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(server.ReceivedRequests()).To(HaveLen(2))
+	})
+
+	It("does not consume the request body", func() {
+		recorder, err := cassette.New(path, cassette.Config{Mode: cassette.ModeRecord, Token: token})
+		Expect(err).NotTo(HaveOccurred())
+
+		req, err := http.NewRequest("POST", server.URL()+apiPrefix+"/products/some-product/releases",
+			strings.NewReader(`{"release":{"version":"2.0.0"}}`))
+		Expect(err).NotTo(HaveOccurred())
+		body := req.Body
+
+		_, err = recorder.RoundTrip(req)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(req.Body).To(BeIdenticalTo(body))
+		Expect(recorder.Cassette().Interactions[0].Request.Body).To(Equal(`{"release":{"version":"2.0.0"}}`))
+	})
+
+	It("sanitizes the tokens of authentication requests and responses", func() {
+		server.RouteToHandler("POST", apiPrefix+"/authentication/access_tokens",
+			ghttp.RespondWith(http.StatusOK, `{"access_token": "some-access-token"}`))
+
+		recorder, err := cassette.New(path, cassette.Config{Mode: cassette.ModeRecord, Token: token})
+		Expect(err).NotTo(HaveOccurred())
+
+		httpClient := &http.Client{Transport: recorder}
+		resp, err := httpClient.Post(server.URL()+apiPrefix+"/authentication/access_tokens", "application/json",
+			strings.NewReader(`{"refresh_token":"some-refresh-token"}`))
+		Expect(err).NotTo(HaveOccurred())
+
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(body)).To(ContainSubstring("some-access-token"))
+
+		interaction := recorder.Cassette().Interactions[0]
+		Expect(interaction.Request.Body).To(Equal(`{"refresh_token":"` + cassette.SanitizedSecret + `"}`))
+		Expect(interaction.Response.Body).To(Equal(`{"access_token": "` + cassette.SanitizedSecret + `"}`))
+	})
+
+	Describe("product file downloads", func() {
+		var contents []byte
+
+		BeforeEach(func() {
+			contents = bytes.Repeat([]byte{0xff}, 4096)
+
+			server.RouteToHandler("POST", apiPrefix+"/products/some-product/releases/1234/product_files/1/download",
+				ghttp.RespondWith(http.StatusFound, nil, http.Header{
+					"Location": {server.URL() + "/some-file?Expires=1&Key-Pair-Id=some-key&Signature=some-signature"},
+				}))
+			server.RouteToHandler("GET", "/some-file",
+				ghttp.RespondWith(http.StatusOK, contents, http.Header{
+					"Content-Type": {"application/octet-stream"},
+				}))
+		})
+
+		download := func(recorder *cassette.Recorder) []byte {
+			httpClient := &http.Client{
+				Transport: recorder,
+				CheckRedirect: func(req *http.Request, via []*http.Request) error {
+					return http.ErrUseLastResponse
+				},
+			}
+
+			resp, err := httpClient.Post(server.URL()+apiPrefix+"/products/some-product/releases/1234/product_files/1/download", "application/json", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusFound))
+
+			resp, err = httpClient.Get(resp.Header.Get("Location"))
+			Expect(err).NotTo(HaveOccurred())
+			defer resp.Body.Close()
+
+			body, err := ioutil.ReadAll(resp.Body)
+			Expect(err).NotTo(HaveOccurred())
+			return body
+		}
+
+		It("sanitizes signed URLs and omits binary bodies", func() {
+			recorder, err := cassette.New(path, cassette.Config{Mode: cassette.ModeRecord, Token: token})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(bytes.Equal(download(recorder), contents)).To(BeTrue())
+			Expect(recorder.Stop()).To(Succeed())
+
+			cassetteContents, err := ioutil.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(cassetteContents)).NotTo(ContainSubstring("some-signature"))
+			Expect(string(cassetteContents)).NotTo(ContainSubstring("some-key"))
+
+			interactions := recorder.Cassette().Interactions
+			Expect(interactions[0].Response.Headers.Get("Location")).To(ContainSubstring("Signature=" + url.QueryEscape(cassette.SanitizedSecret)))
+			Expect(interactions[1].Response.Body).To(BeEmpty())
+			Expect(interactions[1].Response.OmittedBodySize).To(Equal(int64(len(contents))))
+		})
+
+		It("replays omitted bodies as zeros of the recorded size", func() {
+			recorder, err := cassette.New(path, cassette.Config{Mode: cassette.ModeRecord, Token: token})
+			Expect(err).NotTo(HaveOccurred())
+			download(recorder)
+			Expect(recorder.Stop()).To(Succeed())
+
+			recorder, err = cassette.New(path, cassette.Config{Mode: cassette.ModeReplay, Token: token})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(bytes.Equal(download(recorder), make([]byte, len(contents)))).To(BeTrue())
+		})
+	})
+
+	Describe("DownloadForRelease", func() {
+		var (
+			contents []byte
+			host     string
+		)
+
+		BeforeEach(func() {
+			host = server.URL()
+			contents = bytes.Repeat([]byte{0xff}, 4096)
+			sum := sha256.Sum256(contents)
+
+			server.RouteToHandler("GET", apiPrefix+"/products/some-product/releases/1234/product_files/1",
+				ghttp.RespondWithJSONEncoded(http.StatusOK, pivnet.ProductFileResponse{
+					ProductFile: pivnet.ProductFile{
+						ID:     1,
+						SHA256: hex.EncodeToString(sum[:]),
+						Links: &pivnet.Links{
+							Download: map[string]string{"href": server.URL() + apiPrefix + "/products/some-product/releases/1234/product_files/1/download"},
+						},
+					},
+				}))
+			server.RouteToHandler("POST", apiPrefix+"/products/some-product/releases/1234/product_files/1/download",
+				ghttp.RespondWith(http.StatusFound, nil, http.Header{
+					"Location": {server.URL() + "/some-file?Signature=some-signature"},
+				}))
+			server.RouteToHandler("HEAD", "/some-file", serveFile(contents))
+			server.RouteToHandler("GET", "/some-file", serveFile(contents))
+		})
+
+		// Returns the error message, as the errors are too deep to format
+		downloadForRelease := func(recorder *cassette.Recorder) string {
+			client := pivnet.NewClient(pivnet.ClientConfig{
+				Host:            host,
+				Token:           token,
+				Transport:       recorder,
+				VerifyChecksums: true,
+			}, &loggerfakes.FakeLogger{})
+
+			file, err := ioutil.TempFile(dir, "download")
+			Expect(err).NotTo(HaveOccurred())
+			defer file.Close()
+
+			err = client.ProductFiles.DownloadForRelease(file, "some-product", 1234, 1, ioutil.Discard)
+			if err != nil {
+				return err.Error()
+			}
+			return ""
+		}
+
+		It("replays downloads without failing checksum verification", func() {
+			recorder, err := cassette.New(path, cassette.Config{Mode: cassette.ModeRecord, Token: token})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(downloadForRelease(recorder)).To(BeEmpty())
+			Expect(recorder.Stop()).To(Succeed())
+
+			server.Close()
+
+			recorder, err = cassette.New(path, cassette.Config{Mode: cassette.ModeReplay, Token: token})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(downloadForRelease(recorder)).To(BeEmpty())
+		})
+	})
+
+	It("requires an existing cassette to replay", func() {
+		_, err := cassette.New(path, cassette.Config{Mode: cassette.ModeReplay})
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+})
+
+// serveFile serves contents as a binary file, honouring Range headers.
+func serveFile(contents []byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		http.ServeContent(w, r, "some-file", time.Time{}, bytes.NewReader(contents))
+	}
+}
@@ -0,0 +1,121 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet"
+)
+
+const accessTokensPath = "/api/v2/authentication/access_tokens"
+
+// accessTokens authenticates requests with access tokens obtained from
+// the profile's refresh token, starting with any unexpired access token
+// saved in the profile.
+func accessTokens(p Profile) pivnet.Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		t := &accessTokenTransport{
+			url:          strings.TrimSuffix(p.Host, "/") + accessTokensPath,
+			refreshToken: p.RefreshToken,
+			next:         next,
+		}
+
+		if p.AccessToken != "" && time.Now().Unix() < p.AccessTokenExpiry {
+			t.accessToken = p.AccessToken
+		}
+
+		return t
+	}
+}
+
+type accessTokenTransport struct {
+	url          string
+	refreshToken string
+	next         http.RoundTripper
+
+	mutex       sync.Mutex
+	accessToken string
+}
+
+func (t *accessTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Downloads are redirected to storage that rejects extra credentials
+	if !t.isAPI(req.URL) {
+		return t.next.RoundTrip(req)
+	}
+
+	accessToken, err := t.token()
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := t.next.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusUnauthorized {
+		// The access token may have expired, so fetch another next time
+		t.mutex.Lock()
+		if t.accessToken == accessToken {
+			t.accessToken = ""
+		}
+		t.mutex.Unlock()
+	}
+
+	return resp, err
+}
+
+// isAPI reports whether u has the scheme and host of the API.
+func (t *accessTokenTransport) isAPI(u *url.URL) bool {
+	api, err := url.Parse(t.url)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(u.Scheme, api.Scheme) && strings.EqualFold(u.Host, api.Host)
+}
+
+func (t *accessTokenTransport) token() (string, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.accessToken != "" {
+		return t.accessToken, nil
+	}
+
+	body, err := json.Marshal(map[string]string{"refresh_token": t.refreshToken})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest("POST", t.url, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return "", fmt.Errorf("could not fetch access token: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("could not fetch access token: unexpected status code %d", resp.StatusCode)
+	}
+
+	var response struct {
+		AccessToken string `json:"access_token"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	if err != nil {
+		return "", fmt.Errorf("could not fetch access token: %s", err)
+	}
+
+	t.accessToken = response.AccessToken
+	return t.accessToken, nil
+}
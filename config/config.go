@@ -0,0 +1,245 @@
+// Package config loads client configuration from named profiles, as used
+// by pivnet-cli, environment variables and explicit overrides.
+//
+// Each setting is taken from the first of these that sets it:
+//
+//  1. Options.Overrides
+//  2. Environment variables (PIVNET_HOST, PIVNET_TOKEN, ...)
+//  3. The profile in the rc file (~/.pivnetrc, or $PIVNETRC)
+//  4. Defaults, such as pivnet.DefaultHost
+//
+// The profile is Options.Profile, or $PIVNET_PROFILE, or "default".
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/journal"
+)
+
+const (
+	EnvRC                = "PIVNETRC"
+	EnvProfile           = "PIVNET_PROFILE"
+	EnvHost              = "PIVNET_HOST"
+	EnvToken             = "PIVNET_TOKEN"
+	EnvRefreshToken      = "PIVNET_REFRESH_TOKEN"
+	EnvUserAgent         = "PIVNET_USER_AGENT"
+	EnvCACertFiles       = "PIVNET_CA_CERT_FILES"
+	EnvSkipSSLValidation = "PIVNET_SKIP_SSL_VALIDATION"
+
+	DefaultProfile = "default"
+
+	// legacyTokenLength is the length of legacy API tokens. pivnet-cli
+	// reads longer tokens as refresh tokens.
+	legacyTokenLength = 20
+)
+
+type Options struct {
+	// RCPath defaults to DefaultRCPath.
+	RCPath string
+
+	// Profile defaults to $PIVNET_PROFILE, or DefaultProfile.
+	Profile string
+
+	// Overrides takes precedence over every other source.
+	Overrides Profile
+
+	// LookupEnv defaults to os.LookupEnv.
+	LookupEnv func(key string) (string, bool)
+}
+
+// Load resolves a profile from the rc file, environment and overrides.
+func Load(options Options) (Profile, error) {
+	lookupEnv := options.LookupEnv
+	if lookupEnv == nil {
+		lookupEnv = os.LookupEnv
+	}
+
+	rcPath := options.RCPath
+	if rcPath == "" {
+		rcPath, _ = lookupEnv(EnvRC)
+	}
+	if rcPath == "" {
+		rcPath = DefaultRCPath()
+	}
+
+	name := options.Profile
+	if name == "" {
+		name, _ = lookupEnv(EnvProfile)
+	}
+	explicit := name != ""
+	if !explicit {
+		name = DefaultProfile
+	}
+
+	rc, err := LoadRC(rcPath)
+	if err != nil {
+		return Profile{}, err
+	}
+
+	profile, found := rc.Profile(name)
+	if !found && explicit {
+		return Profile{}, fmt.Errorf("profile '%s' not found in '%s'", name, rcPath)
+	}
+	profile.Name = name
+	file := profile
+
+	env, err := envProfile(lookupEnv)
+	if err != nil {
+		return Profile{}, err
+	}
+
+	profile = profile.merge(env.normalized())
+	profile = profile.merge(options.Overrides.normalized())
+
+	if profile.Host == "" {
+		profile.Host = pivnet.DefaultHost
+	}
+
+	if profile.Token == "" && profile.RefreshToken == "" {
+		return Profile{}, fmt.Errorf("no API token configured for profile '%s'", name)
+	}
+
+	profile.source = &profileSource{file: file, resolved: profile}
+
+	return profile, nil
+}
+
+// ClientConfig returns the configuration for pivnet.NewClient. Refresh
+// tokens are exchanged for access tokens as requests are made.
+func (p Profile) ClientConfig() pivnet.ClientConfig {
+	config := pivnet.ClientConfig{
+		Host:              p.Host,
+		Token:             p.Token,
+		UserAgent:         p.UserAgent,
+		SkipSSLValidation: p.SkipSSLValidation != nil && *p.SkipSSLValidation,
+		TLS: pivnet.TLSConfig{
+			CACertFiles: p.CACertFiles,
+		},
+	}
+
+	if p.RefreshToken != "" {
+		config.Token = ""
+		config.JournalActor = journal.Fingerprint(p.RefreshToken)
+		config.Middleware = []pivnet.Middleware{accessTokens(p)}
+	}
+
+	return config
+}
+
+func envProfile(lookupEnv func(string) (string, bool)) (Profile, error) {
+	env := func(key string) string {
+		value, _ := lookupEnv(key)
+		return value
+	}
+
+	profile := Profile{
+		Host:         env(EnvHost),
+		Token:        env(EnvToken),
+		RefreshToken: env(EnvRefreshToken),
+		UserAgent:    env(EnvUserAgent),
+	}
+
+	if files := env(EnvCACertFiles); files != "" {
+		profile.CACertFiles = strings.Split(files, string(os.PathListSeparator))
+	}
+
+	if skip := env(EnvSkipSSLValidation); skip != "" {
+		skipSSLValidation, err := strconv.ParseBool(skip)
+		if err != nil {
+			return Profile{}, fmt.Errorf("invalid %s '%s'", EnvSkipSSLValidation, skip)
+		}
+		profile.SkipSSLValidation = &skipSSLValidation
+	}
+
+	return profile, nil
+}
+
+// normalized moves a refresh token stored as api_token to RefreshToken.
+func (p Profile) normalized() Profile {
+	if len(p.Token) > legacyTokenLength && p.RefreshToken == "" {
+		p.RefreshToken = p.Token
+		p.Token = ""
+	}
+	return p
+}
+
+// denormalized moves RefreshToken to Token, as pivnet-cli stores both in
+// api_token.
+func (p Profile) denormalized() Profile {
+	if p.RefreshToken != "" {
+		p.Token = p.RefreshToken
+		p.RefreshToken = ""
+	}
+	return p
+}
+
+// stored returns the settings of p to write to the rc file: those read
+// from it, and those changed since Load.
+func (p Profile) stored() Profile {
+	if p.source == nil {
+		return p
+	}
+
+	stored := p.source.file
+	resolved := p.source.resolved
+
+	stored.Name = p.Name
+	if p.Host != resolved.Host {
+		stored.Host = p.Host
+	}
+
+	// Access tokens are for the resolved tokens, so are only kept with
+	// tokens from the rc file
+	tokensChanged := p.Token != resolved.Token || p.RefreshToken != resolved.RefreshToken
+	if tokensChanged {
+		stored.Token = p.Token
+		stored.RefreshToken = p.RefreshToken
+	}
+	fileTokens := stored.Token == resolved.Token && stored.RefreshToken == resolved.RefreshToken
+	if tokensChanged || fileTokens {
+		stored.AccessToken = p.AccessToken
+		stored.AccessTokenExpiry = p.AccessTokenExpiry
+	}
+
+	if p.UserAgent != resolved.UserAgent {
+		stored.UserAgent = p.UserAgent
+	}
+	if !reflect.DeepEqual(p.CACertFiles, resolved.CACertFiles) {
+		stored.CACertFiles = p.CACertFiles
+	}
+	if !reflect.DeepEqual(p.SkipSSLValidation, resolved.SkipSSLValidation) {
+		stored.SkipSSLValidation = p.SkipSSLValidation
+	}
+
+	return stored
+}
+
+// merge returns p with the settings that o sets. Setting either kind of
+// token replaces both, so a legacy token never mixes with a refresh token.
+func (p Profile) merge(o Profile) Profile {
+	if o.Host != "" {
+		p.Host = o.Host
+	}
+	if o.Token != "" || o.RefreshToken != "" {
+		p.Token = o.Token
+		p.RefreshToken = o.RefreshToken
+		p.AccessToken = ""
+		p.AccessTokenExpiry = 0
+	}
+	if o.UserAgent != "" {
+		p.UserAgent = o.UserAgent
+	}
+	if len(o.CACertFiles) > 0 {
+		p.CACertFiles = o.CACertFiles
+	}
+	if o.SkipSSLValidation != nil {
+		p.SkipSSLValidation = o.SkipSSLValidation
+	}
+	return p
+}
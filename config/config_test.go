@@ -0,0 +1,327 @@
+package config_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/onsi/gomega/ghttp"
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/config"
+	"github.com/pivotal-cf/go-pivnet/logger/loggerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const refreshToken = "some-refresh-token-longer-than-a-legacy-token"
+
+var _ = Describe("Config", func() {
+	var (
+		dir    string
+		rcPath string
+		env    map[string]string
+	)
+
+	lookupEnv := func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+
+	load := func(options config.Options) (config.Profile, error) {
+		options.RCPath = rcPath
+		options.LookupEnv = lookupEnv
+		return config.Load(options)
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "config")
+		Expect(err).NotTo(HaveOccurred())
+
+		rcPath = filepath.Join(dir, ".pivnetrc")
+		env = map[string]string{}
+
+		Expect(ioutil.WriteFile(rcPath, []byte(`---
+profiles:
+- name: default
+  api_token: legacy-api-token
+  host: https://pivnet-mirror.example.com
+- name: production
+  api_token: `+refreshToken+`
+  access_token: some-access-token
+  access_token_expiry: 1
+`), 0600)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	Describe("Load", func() {
+		It("loads the default profile from the rc file", func() {
+			profile, err := load(config.Options{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.Name).To(Equal("default"))
+			Expect(profile.Host).To(Equal("https://pivnet-mirror.example.com"))
+			Expect(profile.Token).To(Equal("legacy-api-token"))
+			Expect(profile.RefreshToken).To(BeEmpty())
+			Expect(profile.SkipSSLValidation).To(BeNil())
+		})
+
+		It("reads long pivnet-cli tokens as refresh tokens", func() {
+			profile, err := load(config.Options{Profile: "production"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.Token).To(BeEmpty())
+			Expect(profile.RefreshToken).To(Equal(refreshToken))
+			Expect(profile.Host).To(Equal(pivnet.DefaultHost))
+		})
+
+		It("selects the profile from the environment", func() {
+			env[config.EnvProfile] = "production"
+
+			profile, err := load(config.Options{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.Name).To(Equal("production"))
+		})
+
+		It("prefers the environment to the rc file, and overrides to both", func() {
+			env[config.EnvHost] = "https://env.example.com"
+			env[config.EnvToken] = "env-token"
+			env[config.EnvUserAgent] = "env-agent"
+			env[config.EnvSkipSSLValidation] = "true"
+
+			profile, err := load(config.Options{
+				Overrides: config.Profile{Token: "override-token"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.Name).To(Equal("default"))
+			Expect(profile.Host).To(Equal("https://env.example.com"))
+			Expect(profile.Token).To(Equal("override-token"))
+			Expect(profile.UserAgent).To(Equal("env-agent"))
+			Expect(*profile.SkipSSLValidation).To(BeTrue())
+		})
+
+		It("lets the environment and overrides turn SSL validation back on", func() {
+			Expect(ioutil.WriteFile(rcPath, []byte(`---
+profiles:
+- name: default
+  api_token: legacy-api-token
+  skip_ssl_validation: true
+`), 0600)).To(Succeed())
+
+			env[config.EnvSkipSSLValidation] = "false"
+
+			profile, err := load(config.Options{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*profile.SkipSSLValidation).To(BeFalse())
+			Expect(profile.ClientConfig().SkipSSLValidation).To(BeFalse())
+
+			env[config.EnvSkipSSLValidation] = "true"
+			skip := false
+
+			profile, err = load(config.Options{
+				Overrides: config.Profile{SkipSSLValidation: &skip},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*profile.SkipSSLValidation).To(BeFalse())
+		})
+
+		It("replaces a refresh token with a legacy token from a higher source", func() {
+			env[config.EnvToken] = "env-token"
+
+			profile, err := load(config.Options{Profile: "production"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.Token).To(Equal("env-token"))
+			Expect(profile.RefreshToken).To(BeEmpty())
+			Expect(profile.AccessToken).To(BeEmpty())
+		})
+
+		It("returns an error for a missing profile that was asked for", func() {
+			_, err := load(config.Options{Profile: "staging"})
+			Expect(err).To(MatchError(ContainSubstring("profile 'staging' not found")))
+		})
+
+		It("returns an error when no token is configured", func() {
+			rcPath = filepath.Join(dir, "missing")
+
+			_, err := load(config.Options{})
+			Expect(err).To(MatchError("no API token configured for profile 'default'"))
+
+			env[config.EnvToken] = "env-token"
+			_, err = load(config.Options{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns an error for an invalid boolean", func() {
+			env[config.EnvSkipSSLValidation] = "maybe"
+
+			_, err := load(config.Options{})
+			Expect(err).To(MatchError(ContainSubstring(config.EnvSkipSSLValidation)))
+		})
+	})
+
+	Describe("SaveProfile", func() {
+		It("replaces the profile, keeping the others", func() {
+			Expect(config.SaveProfile(rcPath, config.Profile{
+				Name:  "default",
+				Token: "new-api-token",
+			})).To(Succeed())
+
+			rc, err := config.LoadRC(rcPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rc.Profiles).To(HaveLen(2))
+
+			profile, ok := rc.Profile("default")
+			Expect(ok).To(BeTrue())
+			Expect(profile).To(Equal(config.Profile{Name: "default", Token: "new-api-token"}))
+
+			production, ok := rc.Profile("production")
+			Expect(ok).To(BeTrue())
+			Expect(production.AccessToken).To(Equal("some-access-token"))
+
+			info, err := os.Stat(rcPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		})
+
+		It("writes refresh tokens to api_token, as pivnet-cli does", func() {
+			Expect(config.SaveProfile(rcPath, config.Profile{
+				Name:         "staging",
+				RefreshToken: refreshToken,
+			})).To(Succeed())
+
+			b, err := ioutil.ReadFile(rcPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("api_token: " + refreshToken))
+			Expect(string(b)).NotTo(ContainSubstring("refresh_token"))
+
+			profile, err := load(config.Options{Profile: "staging"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.RefreshToken).To(Equal(refreshToken))
+		})
+
+		It("writes back settings from the rc file and changes, but not the environment or overrides", func() {
+			env[config.EnvUserAgent] = "env-agent"
+			env[config.EnvHost] = "https://env.example.com"
+
+			profile, err := load(config.Options{
+				Profile:   "production",
+				Overrides: config.Profile{CACertFiles: []string{"some-ca.pem"}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			profile.AccessToken = "new-access-token"
+			profile.AccessTokenExpiry = 2
+			profile.Host = "https://new.example.com"
+			Expect(config.SaveProfile(rcPath, profile)).To(Succeed())
+
+			rc, err := config.LoadRC(rcPath)
+			Expect(err).NotTo(HaveOccurred())
+
+			saved, ok := rc.Profile("production")
+			Expect(ok).To(BeTrue())
+			Expect(saved).To(Equal(config.Profile{
+				Name:              "production",
+				Host:              "https://new.example.com",
+				RefreshToken:      refreshToken,
+				AccessToken:       "new-access-token",
+				AccessTokenExpiry: 2,
+			}))
+		})
+
+		It("does not write access tokens for tokens from the environment", func() {
+			env[config.EnvRefreshToken] = "env-refresh-token-longer-than-a-legacy-token"
+
+			profile, err := load(config.Options{Profile: "production"})
+			Expect(err).NotTo(HaveOccurred())
+
+			profile.AccessToken = "env-access-token"
+			Expect(config.SaveProfile(rcPath, profile)).To(Succeed())
+
+			rc, err := config.LoadRC(rcPath)
+			Expect(err).NotTo(HaveOccurred())
+
+			saved, _ := rc.Profile("production")
+			Expect(saved.RefreshToken).To(Equal(refreshToken))
+			Expect(saved.AccessToken).To(Equal("some-access-token"))
+		})
+
+		It("creates the rc file", func() {
+			path := filepath.Join(dir, "new", ".pivnetrc")
+			Expect(config.SaveProfile(path, config.Profile{Name: "default", Token: "some-token"})).To(Succeed())
+
+			env[config.EnvRC] = path
+			rcPath = ""
+
+			profile, err := load(config.Options{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.Token).To(Equal("some-token"))
+		})
+	})
+
+	Describe("ClientConfig", func() {
+		var server *ghttp.Server
+
+		BeforeEach(func() {
+			server = ghttp.NewServer()
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("uses legacy tokens directly", func() {
+			server.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/v2/products/some-product"),
+				ghttp.VerifyHeaderKV("Authorization", "Token legacy-api-token"),
+				ghttp.RespondWith(http.StatusOK, `{"id":1}`),
+			))
+
+			profile := config.Profile{Host: server.URL(), Token: "legacy-api-token"}
+			client := pivnet.NewClient(profile.ClientConfig(), &loggerfakes.FakeLogger{})
+
+			_, err := client.Products.Get("some-product")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("exchanges refresh tokens for access tokens", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v2/authentication/access_tokens"),
+					ghttp.VerifyJSON(`{"refresh_token":"`+refreshToken+`"}`),
+					ghttp.RespondWith(http.StatusOK, `{"access_token":"some-access-token"}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v2/products/some-product"),
+					ghttp.VerifyHeaderKV("Authorization", "Bearer some-access-token"),
+					ghttp.RespondWith(http.StatusOK, `{"id":1}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v2/products/some-product"),
+					ghttp.VerifyHeaderKV("Authorization", "Bearer some-access-token"),
+					ghttp.RespondWith(http.StatusOK, `{"id":1}`),
+				),
+			)
+
+			profile := config.Profile{Host: server.URL(), RefreshToken: refreshToken}
+			client := pivnet.NewClient(profile.ClientConfig(), &loggerfakes.FakeLogger{})
+
+			for i := 0; i < 2; i++ {
+				_, err := client.Products.Get("some-product")
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+
+		It("returns an error when the refresh token is rejected", func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusUnauthorized, `{}`))
+
+			profile := config.Profile{Host: server.URL(), RefreshToken: refreshToken}
+			client := pivnet.NewClient(profile.ClientConfig(), &loggerfakes.FakeLogger{})
+
+			_, err := client.Products.Get("some-product")
+			Expect(err).To(MatchError(ContainSubstring("could not fetch access token")))
+		})
+	})
+})
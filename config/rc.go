@@ -0,0 +1,137 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Profile holds the settings for one Pivnet account. It is stored in the
+// pivnet-cli rc file, whose fields it keeps when profiles are written back.
+type Profile struct {
+	Name string `yaml:"name"`
+	Host string `yaml:"host,omitempty"`
+
+	// Token is a legacy API token. pivnet-cli also keeps refresh tokens
+	// in api_token, so tokens longer than a legacy token are read as
+	// RefreshToken, which is written back to api_token.
+	Token        string `yaml:"api_token,omitempty"`
+	RefreshToken string `yaml:"-"`
+
+	AccessToken       string `yaml:"access_token,omitempty"`
+	AccessTokenExpiry int64  `yaml:"access_token_expiry,omitempty"`
+
+	UserAgent   string   `yaml:"user_agent,omitempty"`
+	CACertFiles []string `yaml:"ca_cert_files,omitempty"`
+
+	// SkipSSLValidation is nil when not set, so that a source setting it
+	// to false takes precedence.
+	SkipSSLValidation *bool `yaml:"skip_ssl_validation,omitempty"`
+
+	// source is set by Load, so SaveProfile writes back only settings
+	// from the rc file and settings changed since.
+	source *profileSource
+}
+
+type profileSource struct {
+	file     Profile
+	resolved Profile
+}
+
+type RC struct {
+	Profiles []Profile `yaml:"profiles"`
+}
+
+// DefaultRCPath returns $PIVNETRC, or ~/.pivnetrc as used by pivnet-cli.
+func DefaultRCPath() string {
+	if path := os.Getenv(EnvRC); path != "" {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".pivnetrc"
+	}
+	return filepath.Join(home, ".pivnetrc")
+}
+
+// LoadRC reads an rc file. A missing file has no profiles.
+func LoadRC(path string) (RC, error) {
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return RC{}, nil
+	}
+	if err != nil {
+		return RC{}, err
+	}
+
+	var rc RC
+	err = yaml.Unmarshal(b, &rc)
+	if err != nil {
+		return RC{}, fmt.Errorf("could not parse rc file '%s': %s", path, err)
+	}
+
+	for i, p := range rc.Profiles {
+		rc.Profiles[i] = p.normalized()
+	}
+
+	return rc, nil
+}
+
+// WriteRC writes an rc file readable only by its owner, as it holds
+// tokens.
+func WriteRC(path string, rc RC) error {
+	stored := RC{}
+	for _, p := range rc.Profiles {
+		stored.Profiles = append(stored.Profiles, p.denormalized())
+	}
+
+	b, err := yaml.Marshal(stored)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, b, 0600)
+}
+
+func (rc RC) Profile(name string) (Profile, bool) {
+	for _, p := range rc.Profiles {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return Profile{}, false
+}
+
+// SetProfile replaces the profile with the same name, or adds it.
+func (rc *RC) SetProfile(profile Profile) {
+	for i, p := range rc.Profiles {
+		if p.Name == profile.Name {
+			rc.Profiles[i] = profile
+			return
+		}
+	}
+	rc.Profiles = append(rc.Profiles, profile)
+}
+
+// SaveProfile adds or replaces a profile in the rc file at path, keeping
+// its other profiles. For a profile returned by Load, settings from the
+// environment or overrides are not written unless they have been changed.
+func SaveProfile(path string, profile Profile) error {
+	rc, err := LoadRC(path)
+	if err != nil {
+		return err
+	}
+
+	rc.SetProfile(profile.stored())
+
+	return WriteRC(path, rc)
+}
@@ -2,9 +2,14 @@ package pivnet
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
+
+	"github.com/pivotal-cf/go-pivnet/specifier"
+	"github.com/pivotal-cf/go-pivnet/tracing"
 )
 
 type DependencySpecifiersService struct {
@@ -25,7 +30,22 @@ type DependencySpecifier struct {
 	Specifier string  `json:"specifier,omitempty" yaml:"specifier,omitempty"`
 }
 
+type ResolvedDependencySpecifier struct {
+	DependencySpecifier DependencySpecifier `json:"dependency_specifier" yaml:"dependency_specifier"`
+	Releases            []Release           `json:"releases" yaml:"releases"`
+}
+
 func (r DependencySpecifiersService) List(productSlug string, releaseID int) ([]DependencySpecifier, error) {
+	return r.ListWithContext(context.Background(), productSlug, releaseID)
+}
+
+func (r DependencySpecifiersService) ListWithContext(ctx context.Context, productSlug string, releaseID int) ([]DependencySpecifier, error) {
+	ctx, span := r.client.startSpan(ctx, "DependencySpecifiers.List",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/dependency_specifiers",
 		productSlug,
@@ -33,7 +53,8 @@ func (r DependencySpecifiersService) List(productSlug string, releaseID int) ([]
 	)
 
 	var response DependencySpecifiersResponse
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestWithContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -53,6 +74,16 @@ func (r DependencySpecifiersService) List(productSlug string, releaseID int) ([]
 }
 
 func (r DependencySpecifiersService) Get(productSlug string, releaseID int, dependencySpecifierID int) (DependencySpecifier, error) {
+	return r.GetWithContext(context.Background(), productSlug, releaseID, dependencySpecifierID)
+}
+
+func (r DependencySpecifiersService) GetWithContext(ctx context.Context, productSlug string, releaseID int, dependencySpecifierID int) (DependencySpecifier, error) {
+	ctx, span := r.client.startSpan(ctx, "DependencySpecifiers.Get",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/dependency_specifiers/%d",
 		productSlug,
@@ -60,7 +91,8 @@ func (r DependencySpecifiersService) Get(productSlug string, releaseID int, depe
 		dependencySpecifierID,
 	)
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestWithContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -86,6 +118,22 @@ func (r DependencySpecifiersService) Create(
 	dependentProductSlug string,
 	specifier string,
 ) (DependencySpecifier, error) {
+	return r.CreateWithContext(context.Background(), productSlug, releaseID, dependentProductSlug, specifier)
+}
+
+func (r DependencySpecifiersService) CreateWithContext(
+	ctx context.Context,
+	productSlug string,
+	releaseID int,
+	dependentProductSlug string,
+	specifier string,
+) (DependencySpecifier, error) {
+	ctx, span := r.client.startSpan(ctx, "DependencySpecifiers.Create",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/dependency_specifiers",
 		productSlug,
@@ -106,7 +154,8 @@ func (r DependencySpecifiersService) Create(
 		return DependencySpecifier{}, err
 	}
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestWithContext(
+		ctx,
 		"POST",
 		url,
 		http.StatusCreated,
@@ -131,6 +180,21 @@ func (r DependencySpecifiersService) Delete(
 	releaseID int,
 	dependencySpecifierID int,
 ) error {
+	return r.DeleteWithContext(context.Background(), productSlug, releaseID, dependencySpecifierID)
+}
+
+func (r DependencySpecifiersService) DeleteWithContext(
+	ctx context.Context,
+	productSlug string,
+	releaseID int,
+	dependencySpecifierID int,
+) error {
+	ctx, span := r.client.startSpan(ctx, "DependencySpecifiers.Delete",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/dependency_specifiers/%d",
 		productSlug,
@@ -138,7 +202,8 @@ func (r DependencySpecifiersService) Delete(
 		dependencySpecifierID,
 	)
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestWithContext(
+		ctx,
 		"DELETE",
 		url,
 		http.StatusNoContent,
@@ -152,6 +217,82 @@ func (r DependencySpecifiersService) Delete(
 	return nil
 }
 
+// Resolve returns, for each dependency specifier of the release, the
+// releases of the dependent product that satisfy it, newest first.
+func (r DependencySpecifiersService) Resolve(productSlug string, releaseID int) ([]ResolvedDependencySpecifier, error) {
+	return r.ResolveWithContext(context.Background(), productSlug, releaseID)
+}
+
+func (r DependencySpecifiersService) ResolveWithContext(ctx context.Context, productSlug string, releaseID int) ([]ResolvedDependencySpecifier, error) {
+	ctx, span := r.client.startSpan(ctx, "DependencySpecifiers.Resolve",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+	)
+	defer span.End()
+
+	dependencySpecifiers, err := r.ListWithContext(ctx, productSlug, releaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	releasesService := ReleasesService{client: r.client, l: r.client.logger}
+	candidates := map[string][]Release{}
+
+	var resolved []ResolvedDependencySpecifier
+	for _, dependencySpecifier := range dependencySpecifiers {
+		dependentProductSlug := dependencySpecifier.Product.Slug
+
+		releases, ok := candidates[dependentProductSlug]
+		if !ok {
+			releases, err = releasesService.ListWithContext(ctx, dependentProductSlug)
+			if err != nil {
+				return nil, err
+			}
+			candidates[dependentProductSlug] = releases
+		}
+
+		matching, err := ReleasesMatchingSpecifier(dependencySpecifier.Specifier, releases)
+		if err != nil {
+			return nil, err
+		}
+
+		resolved = append(resolved, ResolvedDependencySpecifier{
+			DependencySpecifier: dependencySpecifier,
+			Releases:            matching,
+		})
+	}
+
+	return resolved, nil
+}
+
+// ReleasesMatchingSpecifier returns the releases whose version satisfies
+// the specifier, newest first.
+func ReleasesMatchingSpecifier(s string, releases []Release) ([]Release, error) {
+	spec, err := specifier.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	matching := []Release{}
+	for _, release := range releases {
+		if spec.Matches(release.Version) {
+			matching = append(matching, release)
+		}
+	}
+
+	SortReleasesNewestFirst(matching)
+
+	return matching, nil
+}
+
+// SortReleasesNewestFirst sorts releases by descending version.
+// Releases whose versions cannot be parsed are sorted last.
+func SortReleasesNewestFirst(releases []Release) {
+	sort.SliceStable(releases, func(i, j int) bool {
+		return specifier.Compare(releases[i].Version, releases[j].Version) > 0
+	})
+}
+
 type createDependencySpecifierBody struct {
 	DependencySpecifier createDependencySpecifierBodyDependencySpecifier `json:"dependency_specifier"`
 }
@@ -454,4 +454,109 @@ var _ = Describe("PivnetClient - dependency specifiers", func() {
 			})
 		})
 	})
+
+	Describe("Resolve", func() {
+		var (
+			specifiersURL string
+		)
+
+		BeforeEach(func() {
+			specifiersURL = fmt.Sprintf(
+				"%s/products/%s/releases/%d/dependency_specifiers",
+				apiPrefix,
+				productSlug,
+				releaseID,
+			)
+		})
+
+		It("returns the matching releases for each specifier, newest first", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", specifiersURL),
+					ghttp.RespondWith(http.StatusOK, `{"dependency_specifiers":[
+						{"id":1,"product":{"slug":"other-product"},"specifier":"1.2.*"},
+						{"id":2,"product":{"slug":"other-product"},"specifier":"~> 1.9"}
+					]}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", fmt.Sprintf("%s/products/other-product/releases", apiPrefix)),
+					ghttp.RespondWith(http.StatusOK, `{"releases":[
+						{"id":10,"version":"1.2.9"},
+						{"id":11,"version":"1.2.10"},
+						{"id":12,"version":"1.10.0"},
+						{"id":13,"version":"2.0.0"},
+						{"id":14,"version":"1.2.10-rc.1"}
+					]}`),
+				),
+			)
+
+			resolved, err := client.DependencySpecifiers.Resolve(productSlug, releaseID)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(resolved).To(HaveLen(2))
+
+			Expect(resolved[0].DependencySpecifier.ID).To(Equal(1))
+			Expect(releaseIDs(resolved[0].Releases)).To(Equal([]int{11, 14, 10}))
+
+			Expect(resolved[1].DependencySpecifier.ID).To(Equal(2))
+			Expect(releaseIDs(resolved[1].Releases)).To(Equal([]int{12}))
+		})
+
+		Context("when a specifier cannot be parsed", func() {
+			It("returns an error", func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", specifiersURL),
+						ghttp.RespondWith(http.StatusOK, `{"dependency_specifiers":[
+							{"id":1,"product":{"slug":"other-product"},"specifier":">= 1.*"}
+						]}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", fmt.Sprintf("%s/products/other-product/releases", apiPrefix)),
+						ghttp.RespondWith(http.StatusOK, `{"releases":[]}`),
+					),
+				)
+
+				_, err := client.DependencySpecifiers.Resolve(productSlug, releaseID)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("invalid specifier '>= 1.*'"))
+			})
+		})
+
+		Context("when listing releases fails", func() {
+			It("returns the error", func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", specifiersURL),
+						ghttp.RespondWith(http.StatusOK, `{"dependency_specifiers":[
+							{"id":1,"product":{"slug":"other-product"},"specifier":"1.2.*"}
+						]}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", fmt.Sprintf("%s/products/other-product/releases", apiPrefix)),
+						ghttp.RespondWith(http.StatusTeapot, `{"message":"foo message"}`),
+					),
+				)
+
+				_, err := client.DependencySpecifiers.Resolve(productSlug, releaseID)
+				Expect(err.Error()).To(ContainSubstring("foo message"))
+			})
+		})
+	})
+
+	Describe("ReleasesMatchingSpecifier", func() {
+		It("returns an empty list when nothing matches", func() {
+			matching, err := pivnet.ReleasesMatchingSpecifier("3.*", []pivnet.Release{{ID: 1, Version: "1.0.0"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(matching).To(BeEmpty())
+		})
+	})
 })
+
+func releaseIDs(releases []pivnet.Release) []int {
+	ids := make([]int, len(releases))
+	for i, release := range releases {
+		ids[i] = release.ID
+	}
+	return ids
+}
@@ -0,0 +1,183 @@
+// Package depgraph builds the transitive dependency graph of a release
+// across products.
+package depgraph
+
+import (
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/logger"
+)
+
+type Builder struct {
+	client pivnet.Client
+	logger logger.Logger
+}
+
+func NewBuilder(client pivnet.Client, logger logger.Logger) Builder {
+	return Builder{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Build walks the explicit dependencies and dependency specifiers of the
+// release, and of every release they lead to.
+//
+// A dependency specifier is followed to the newest release it matches,
+// while every release it matches is considered when looking for conflicts.
+func (b Builder) Build(productSlug string, releaseID int) (Graph, error) {
+	root, err := b.client.Releases.Get(productSlug, releaseID)
+	if err != nil {
+		return Graph{}, err
+	}
+
+	w := walker{
+		client:   b.client,
+		logger:   b.logger,
+		graph:    Graph{Root: nodeID(productSlug, root.Version)},
+		nodes:    map[string]bool{},
+		releases: map[string][]pivnet.Release{},
+	}
+
+	queue := []Node{w.addNode(productSlug, root.ID, root.Version)}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		next, err := w.visit(node)
+		if err != nil {
+			return Graph{}, err
+		}
+
+		queue = append(queue, next...)
+	}
+
+	w.graph.Cycles, w.graph.CyclesTruncated = findCycles(w.graph)
+	w.graph.Conflicts = findConflicts(w.graph)
+	w.graph.Unsatisfied = findUnsatisfied(w.graph)
+
+	return w.graph, nil
+}
+
+type walker struct {
+	client pivnet.Client
+	logger logger.Logger
+	graph  Graph
+
+	nodes    map[string]bool
+	releases map[string][]pivnet.Release
+}
+
+// addNode adds the node if it has not been seen before and returns it.
+func (w *walker) addNode(productSlug string, releaseID int, version string) Node {
+	node := Node{
+		ID:          nodeID(productSlug, version),
+		ProductSlug: productSlug,
+		ReleaseID:   releaseID,
+		Version:     version,
+	}
+
+	if !w.nodes[node.ID] {
+		w.nodes[node.ID] = true
+		w.graph.Nodes = append(w.graph.Nodes, node)
+	}
+
+	return node
+}
+
+// visit records the requirements of node and returns the nodes it
+// leads to that have not been visited yet.
+func (w *walker) visit(node Node) ([]Node, error) {
+	w.logger.Debug("Visiting release", logger.Data{"release": node.ID})
+
+	var next []Node
+	follow := func(productSlug string, release pivnet.Release) Node {
+		seen := w.nodes[nodeID(productSlug, release.Version)]
+		to := w.addNode(productSlug, release.ID, release.Version)
+		if !seen {
+			next = append(next, to)
+		}
+		return to
+	}
+
+	dependencies, err := w.client.ReleaseDependencies.List(node.ProductSlug, node.ReleaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dependency := range dependencies {
+		release := pivnet.Release{
+			ID:      dependency.Release.ID,
+			Version: dependency.Release.Version,
+		}
+		productSlug := dependency.Release.Product.Slug
+
+		to := follow(productSlug, release)
+
+		w.graph.Edges = append(w.graph.Edges, Edge{From: node.ID, To: to.ID})
+		w.graph.Requirements = append(w.graph.Requirements, Requirement{
+			From:        node.ID,
+			ProductSlug: productSlug,
+			Versions:    []string{release.Version},
+		})
+	}
+
+	dependencySpecifiers, err := w.client.DependencySpecifiers.List(node.ProductSlug, node.ReleaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dependencySpecifier := range dependencySpecifiers {
+		productSlug := dependencySpecifier.Product.Slug
+
+		candidates, err := w.productReleases(productSlug)
+		if err != nil {
+			return nil, err
+		}
+
+		matching, err := pivnet.ReleasesMatchingSpecifier(dependencySpecifier.Specifier, candidates)
+		if err != nil {
+			return nil, err
+		}
+
+		versions := make([]string, len(matching))
+		for i, release := range matching {
+			versions[i] = release.Version
+		}
+
+		w.graph.Requirements = append(w.graph.Requirements, Requirement{
+			From:        node.ID,
+			ProductSlug: productSlug,
+			Specifier:   dependencySpecifier.Specifier,
+			Versions:    versions,
+		})
+
+		if len(matching) == 0 {
+			continue
+		}
+
+		to := follow(productSlug, matching[0])
+
+		w.graph.Edges = append(w.graph.Edges, Edge{
+			From:      node.ID,
+			To:        to.ID,
+			Specifier: dependencySpecifier.Specifier,
+		})
+	}
+
+	return next, nil
+}
+
+func (w *walker) productReleases(productSlug string) ([]pivnet.Release, error) {
+	releases, ok := w.releases[productSlug]
+	if ok {
+		return releases, nil
+	}
+
+	releases, err := w.client.Releases.List(productSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	w.releases[productSlug] = releases
+	return releases, nil
+}
@@ -0,0 +1,277 @@
+package depgraph_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/onsi/gomega/ghttp"
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/depgraph"
+	"github.com/pivotal-cf/go-pivnet/logger/loggerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Builder", func() {
+	var (
+		server  *ghttp.Server
+		builder depgraph.Builder
+	)
+
+	releaseURL := func(productSlug string, releaseID int, suffix string) string {
+		return fmt.Sprintf("%s/products/%s/releases/%d%s", apiPrefix, productSlug, releaseID, suffix)
+	}
+
+	routeRelease := func(productSlug string, releaseID int, dependencies string, specifiers string) {
+		server.RouteToHandler("GET", releaseURL(productSlug, releaseID, "/dependencies"),
+			ghttp.RespondWith(http.StatusOK, fmt.Sprintf(`{"dependencies":[%s]}`, dependencies)))
+		server.RouteToHandler("GET", releaseURL(productSlug, releaseID, "/dependency_specifiers"),
+			ghttp.RespondWith(http.StatusOK, fmt.Sprintf(`{"dependency_specifiers":[%s]}`, specifiers)))
+	}
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+
+		fakeLogger := &loggerfakes.FakeLogger{}
+		client := pivnet.NewClient(pivnet.ClientConfig{
+			Host:      server.URL(),
+			Token:     "my-auth-token",
+			UserAgent: "pivnet-resource/0.1.0 (some-url)",
+		}, fakeLogger)
+
+		builder = depgraph.NewBuilder(client, fakeLogger)
+
+		server.RouteToHandler("GET", releaseURL("a", 1, ""),
+			ghttp.RespondWith(http.StatusOK, `{"id":1,"version":"1.0.0"}`))
+		server.RouteToHandler("GET", fmt.Sprintf("%s/products/c/releases", apiPrefix),
+			ghttp.RespondWith(http.StatusOK, `{"releases":[
+				{"id":31,"version":"3.1.0"},
+				{"id":32,"version":"3.2.0"},
+				{"id":40,"version":"4.0.0"}
+			]}`))
+		server.RouteToHandler("GET", fmt.Sprintf("%s/products/d/releases", apiPrefix),
+			ghttp.RespondWith(http.StatusOK, `{"releases":[{"id":50,"version":"5.0.0"}]}`))
+
+		routeRelease("a", 1,
+			`{"release":{"id":2,"version":"2.0.0","product":{"slug":"b"}}}`,
+			`{"id":1,"product":{"slug":"c"},"specifier":"~> 3.1"}`,
+		)
+		routeRelease("b", 2,
+			`{"release":{"id":31,"version":"3.1.0","product":{"slug":"c"}}}`,
+			`{"id":2,"product":{"slug":"d"},"specifier":"5.*"}`,
+		)
+		routeRelease("c", 31, "", "")
+		routeRelease("c", 32, "", "")
+		routeRelease("d", 50, "", "")
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("walks explicit dependencies and specifiers transitively", func() {
+		graph, err := builder.Build("a", 1)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(graph.Root).To(Equal("a@1.0.0"))
+
+		var ids []string
+		for _, node := range graph.Nodes {
+			ids = append(ids, node.ID)
+		}
+		Expect(ids).To(Equal([]string{"a@1.0.0", "b@2.0.0", "c@3.2.0", "c@3.1.0", "d@5.0.0"}))
+
+		Expect(graph.Edges).To(Equal([]depgraph.Edge{
+			{From: "a@1.0.0", To: "b@2.0.0"},
+			{From: "a@1.0.0", To: "c@3.2.0", Specifier: "~> 3.1"},
+			{From: "b@2.0.0", To: "c@3.1.0"},
+			{From: "b@2.0.0", To: "d@5.0.0", Specifier: "5.*"},
+		}))
+
+		Expect(graph.Cycles).To(BeEmpty())
+		Expect(graph.Conflicts).To(BeEmpty())
+	})
+
+	It("only fetches each product's releases once", func() {
+		routeRelease("d", 50, "", `{"id":3,"product":{"slug":"c"},"specifier":"3.*"}`)
+
+		_, err := builder.Build("a", 1)
+		Expect(err).NotTo(HaveOccurred())
+
+		var listCalls int
+		for _, req := range server.ReceivedRequests() {
+			if req.URL.Path == fmt.Sprintf("%s/products/c/releases", apiPrefix) {
+				listCalls++
+			}
+		}
+		Expect(listCalls).To(Equal(1))
+	})
+
+	Context("when releases depend on each other", func() {
+		BeforeEach(func() {
+			routeRelease("c", 32,
+				`{"release":{"id":1,"version":"1.0.0","product":{"slug":"a"}}}`,
+				"",
+			)
+		})
+
+		It("reports the cycle once", func() {
+			graph, err := builder.Build("a", 1)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(graph.Nodes).To(HaveLen(5))
+			Expect(graph.Cycles).To(Equal([][]string{{"a@1.0.0", "c@3.2.0"}}))
+		})
+	})
+
+	Context("when a cycle is reachable along two paths", func() {
+		BeforeEach(func() {
+			routeRelease("c", 31,
+				`{"release":{"id":1,"version":"1.0.0","product":{"slug":"a"}}}`,
+				"",
+			)
+			routeRelease("c", 32,
+				`{"release":{"id":2,"version":"2.0.0","product":{"slug":"b"}}}`,
+				"",
+			)
+		})
+
+		It("reports every cycle", func() {
+			graph, err := builder.Build("a", 1)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(graph.Cycles).To(Equal([][]string{
+				{"a@1.0.0", "b@2.0.0", "c@3.1.0"},
+				{"a@1.0.0", "c@3.2.0", "b@2.0.0", "c@3.1.0"},
+			}))
+		})
+	})
+
+	Context("when many releases all depend on each other", func() {
+		const releases = 20
+
+		BeforeEach(func() {
+			release := func(id int) string {
+				return fmt.Sprintf(`{"release":{"id":%d,"version":"%d.0.0","product":{"slug":"e"}}}`, id, id)
+			}
+
+			routeRelease("a", 1, release(1), "")
+			for id := 1; id <= releases; id++ {
+				var dependencies []string
+				for other := 1; other <= releases; other++ {
+					if other != id {
+						dependencies = append(dependencies, release(other))
+					}
+				}
+				routeRelease("e", id, strings.Join(dependencies, ","), "")
+			}
+		})
+
+		It("lists a bounded number of cycles without hanging", func(done Done) {
+			graph, err := builder.Build("a", 1)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(graph.Nodes).To(HaveLen(releases + 1))
+			Expect(graph.Cycles).To(HaveLen(depgraph.MaxCycles))
+			Expect(graph.CyclesTruncated).To(BeTrue())
+			Expect(graph.Cycles[0]).To(Equal([]string{"e@1.0.0", "e@2.0.0"}))
+
+			close(done)
+		}, 10)
+	})
+
+	Context("when two releases require incompatible releases of a product", func() {
+		BeforeEach(func() {
+			routeRelease("d", 50, "", `{"id":3,"product":{"slug":"c"},"specifier":"4.*"}`)
+			routeRelease("c", 40, "", "")
+		})
+
+		It("reports a conflict listing each requirement", func() {
+			graph, err := builder.Build("a", 1)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(graph.Conflicts).To(HaveLen(1))
+			Expect(graph.Conflicts[0].ProductSlug).To(Equal("c"))
+			Expect(graph.Conflicts[0].String()).To(Equal(
+				"no release of 'c' satisfies all requirements: " +
+					"a@1.0.0 requires '~> 3.1'; b@2.0.0 requires 3.1.0; d@5.0.0 requires '4.*'",
+			))
+		})
+	})
+
+	Context("when a specifier matches no release", func() {
+		BeforeEach(func() {
+			routeRelease("b", 2, "", `{"id":2,"product":{"slug":"d"},"specifier":"6.*"}`)
+		})
+
+		It("reports it as unsatisfied and does not follow it", func() {
+			graph, err := builder.Build("a", 1)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(graph.Nodes).To(HaveLen(3))
+			Expect(graph.Conflicts).To(BeEmpty())
+			Expect(graph.Unsatisfied).To(Equal([]depgraph.Requirement{{
+				From:        "b@2.0.0",
+				ProductSlug: "d",
+				Specifier:   "6.*",
+				Versions:    []string{},
+			}}))
+		})
+	})
+
+	Context("when fetching dependencies fails", func() {
+		BeforeEach(func() {
+			server.RouteToHandler("GET", releaseURL("b", 2, "/dependencies"),
+				ghttp.RespondWith(http.StatusTeapot, `{"message":"foo message"}`))
+		})
+
+		It("returns the error", func() {
+			_, err := builder.Build("a", 1)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("foo message"))
+		})
+	})
+
+	Describe("exporting", func() {
+		var (
+			graph depgraph.Graph
+		)
+
+		BeforeEach(func() {
+			var err error
+			graph, err = builder.Build("a", 1)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("writes Graphviz DOT", func() {
+			var buf bytes.Buffer
+			Expect(graph.WriteDOT(&buf)).To(Succeed())
+
+			Expect(buf.String()).To(Equal(`digraph dependencies {
+  "a@1.0.0" [label="a\n1.0.0", shape=box];
+  "b@2.0.0" [label="b\n2.0.0"];
+  "c@3.2.0" [label="c\n3.2.0"];
+  "c@3.1.0" [label="c\n3.1.0"];
+  "d@5.0.0" [label="d\n5.0.0"];
+  "a@1.0.0" -> "b@2.0.0";
+  "a@1.0.0" -> "c@3.2.0" [label="~> 3.1", style=dashed];
+  "b@2.0.0" -> "c@3.1.0";
+  "b@2.0.0" -> "d@5.0.0" [label="5.*", style=dashed];
+}
+`))
+		})
+
+		It("writes JSON that round-trips", func() {
+			var buf bytes.Buffer
+			Expect(graph.WriteJSON(&buf)).To(Succeed())
+
+			var decoded depgraph.Graph
+			Expect(json.Unmarshal(buf.Bytes(), &decoded)).To(Succeed())
+			Expect(decoded).To(Equal(graph))
+		})
+	})
+})
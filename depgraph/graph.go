@@ -0,0 +1,322 @@
+package depgraph
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// MaxCycles is the most cycles listed in a graph. Dense graphs can have
+// exponentially many.
+const MaxCycles = 1000
+
+type Graph struct {
+	Root         string        `json:"root" yaml:"root"`
+	Nodes        []Node        `json:"nodes" yaml:"nodes"`
+	Edges        []Edge        `json:"edges" yaml:"edges"`
+	Requirements []Requirement `json:"requirements" yaml:"requirements"`
+	Cycles       [][]string    `json:"cycles,omitempty" yaml:"cycles,omitempty"`
+	Conflicts    []Conflict    `json:"conflicts,omitempty" yaml:"conflicts,omitempty"`
+
+	// CyclesTruncated is set when there are more than MaxCycles cycles,
+	// only the first of which are listed in Cycles.
+	CyclesTruncated bool `json:"cycles_truncated,omitempty" yaml:"cycles_truncated,omitempty"`
+
+	// Unsatisfied lists the dependency specifiers that match no release.
+	Unsatisfied []Requirement `json:"unsatisfied,omitempty" yaml:"unsatisfied,omitempty"`
+}
+
+// Node is a single release, identified by product slug and version.
+type Node struct {
+	ID          string `json:"id" yaml:"id"`
+	ProductSlug string `json:"product_slug" yaml:"product_slug"`
+	ReleaseID   int    `json:"release_id" yaml:"release_id"`
+	Version     string `json:"version" yaml:"version"`
+}
+
+// Edge is an explicit dependency, or the release a dependency
+// specifier resolved to when Specifier is set.
+type Edge struct {
+	From      string `json:"from" yaml:"from"`
+	To        string `json:"to" yaml:"to"`
+	Specifier string `json:"specifier,omitempty" yaml:"specifier,omitempty"`
+}
+
+// Requirement lists the versions of a product that one release accepts.
+type Requirement struct {
+	From        string   `json:"from" yaml:"from"`
+	ProductSlug string   `json:"product_slug" yaml:"product_slug"`
+	Specifier   string   `json:"specifier,omitempty" yaml:"specifier,omitempty"`
+	Versions    []string `json:"versions" yaml:"versions"`
+}
+
+// Conflict is a product for which no single release satisfies
+// every requirement on it, though each requirement matches some release.
+type Conflict struct {
+	ProductSlug  string        `json:"product_slug" yaml:"product_slug"`
+	Requirements []Requirement `json:"requirements" yaml:"requirements"`
+}
+
+func (c Conflict) String() string {
+	descriptions := make([]string, len(c.Requirements))
+	for i, r := range c.Requirements {
+		if r.Specifier != "" {
+			descriptions[i] = fmt.Sprintf("%s requires '%s'", r.From, r.Specifier)
+		} else {
+			descriptions[i] = fmt.Sprintf("%s requires %s", r.From, strings.Join(r.Versions, ", "))
+		}
+	}
+
+	return fmt.Sprintf(
+		"no release of '%s' satisfies all requirements: %s",
+		c.ProductSlug,
+		strings.Join(descriptions, "; "),
+	)
+}
+
+func (g Graph) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(g)
+}
+
+// WriteDOT writes the graph in Graphviz DOT format. Nodes in a cycle or
+// with a conflicting product are highlighted in red, and nodes with an
+// unsatisfied requirement in orange.
+func (g Graph) WriteDOT(w io.Writer) error {
+	highlighted := map[string]bool{}
+	for _, cycle := range g.Cycles {
+		for _, id := range cycle {
+			highlighted[id] = true
+		}
+	}
+	conflicting := map[string]bool{}
+	for _, conflict := range g.Conflicts {
+		conflicting[conflict.ProductSlug] = true
+	}
+	unsatisfied := map[string]bool{}
+	for _, requirement := range g.Unsatisfied {
+		unsatisfied[requirement.From] = true
+	}
+
+	lines := []string{"digraph dependencies {"}
+
+	for _, node := range g.Nodes {
+		attrs := fmt.Sprintf("label=%s", quote(node.ProductSlug+"\n"+node.Version))
+		if node.ID == g.Root {
+			attrs += ", shape=box"
+		}
+		if highlighted[node.ID] || conflicting[node.ProductSlug] {
+			attrs += ", color=red"
+		} else if unsatisfied[node.ID] {
+			attrs += ", color=orange"
+		}
+		lines = append(lines, fmt.Sprintf("  %s [%s];", quote(node.ID), attrs))
+	}
+
+	for _, edge := range g.Edges {
+		line := fmt.Sprintf("  %s -> %s", quote(edge.From), quote(edge.To))
+		if edge.Specifier != "" {
+			line += fmt.Sprintf(" [label=%s, style=dashed]", quote(edge.Specifier))
+		}
+		lines = append(lines, line+";")
+	}
+
+	lines = append(lines, "}")
+
+	_, err := io.WriteString(w, strings.Join(lines, "\n")+"\n")
+	return err
+}
+
+func quote(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, `"`, `\"`, -1)
+	s = strings.Replace(s, "\n", `\n`, -1)
+	return `"` + s + `"`
+}
+
+func nodeID(productSlug string, version string) string {
+	return fmt.Sprintf("%s@%s", productSlug, version)
+}
+
+// findCycles returns up to MaxCycles elementary cycles, each once and
+// starting from its lexically smallest node, and whether there were more.
+//
+// It is Johnson's algorithm: each node in turn is the start of a search
+// through the larger nodes in its strongly connected component, and nodes
+// that cannot lead back to the start stay blocked until one on the path
+// can, so the work is bounded by the number of cycles found.
+func findCycles(g Graph) ([][]string, bool) {
+	adjacent := map[string][]string{}
+	reverse := map[string][]string{}
+	linked := map[Edge]bool{}
+	for _, edge := range g.Edges {
+		link := Edge{From: edge.From, To: edge.To}
+		if !linked[link] {
+			linked[link] = true
+			adjacent[edge.From] = append(adjacent[edge.From], edge.To)
+			reverse[edge.To] = append(reverse[edge.To], edge.From)
+		}
+	}
+
+	ids := make([]string, len(g.Nodes))
+	for i, node := range g.Nodes {
+		ids[i] = node.ID
+	}
+	sort.Strings(ids)
+
+	var cycles [][]string
+
+	for _, start := range ids {
+		// The component of start among the nodes not yet taken as a start
+		// is what it reaches and is reached from
+		larger := func(id string) bool { return id >= start }
+		reached := reachable(start, adjacent, larger)
+		component := map[string]bool{}
+		for id := range reachable(start, reverse, larger) {
+			component[id] = reached[id]
+		}
+
+		blocked := map[string]bool{}
+		blockedBy := map[string]map[string]bool{}
+		var stack []string
+
+		var unblock func(id string)
+		unblock = func(id string) {
+			blocked[id] = false
+			for other := range blockedBy[id] {
+				delete(blockedBy[id], other)
+				if blocked[other] {
+					unblock(other)
+				}
+			}
+		}
+
+		var circuit func(id string) bool
+		circuit = func(id string) bool {
+			found := false
+			stack = append(stack, id)
+			blocked[id] = true
+
+			for _, next := range adjacent[id] {
+				if len(cycles) == MaxCycles {
+					break
+				}
+				switch {
+				case next == start:
+					cycles = append(cycles, append([]string{}, stack...))
+					found = true
+				case component[next] && !blocked[next]:
+					if circuit(next) {
+						found = true
+					}
+				}
+			}
+
+			if found {
+				unblock(id)
+			} else {
+				for _, next := range adjacent[id] {
+					if !component[next] {
+						continue
+					}
+					if blockedBy[next] == nil {
+						blockedBy[next] = map[string]bool{}
+					}
+					blockedBy[next][id] = true
+				}
+			}
+
+			stack = stack[:len(stack)-1]
+			return found
+		}
+
+		circuit(start)
+
+		if len(cycles) == MaxCycles {
+			return cycles, true
+		}
+	}
+
+	return cycles, false
+}
+
+// reachable returns the nodes reachable from id through nodes that are
+// included, including id itself.
+func reachable(id string, adjacent map[string][]string, included func(id string) bool) map[string]bool {
+	seen := map[string]bool{id: true}
+	queue := []string{id}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, next := range adjacent[current] {
+			if !seen[next] && included(next) {
+				seen[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return seen
+}
+
+// findConflicts returns the products, in order of slug, whose
+// requirements have no version in common. Requirements matching no
+// release are left to findUnsatisfied.
+func findConflicts(g Graph) []Conflict {
+	byProduct := map[string][]Requirement{}
+	for _, requirement := range g.Requirements {
+		if len(requirement.Versions) == 0 {
+			continue
+		}
+		byProduct[requirement.ProductSlug] = append(byProduct[requirement.ProductSlug], requirement)
+	}
+
+	var slugs []string
+	for slug := range byProduct {
+		slugs = append(slugs, slug)
+	}
+	sort.Strings(slugs)
+
+	var conflicts []Conflict
+	for _, slug := range slugs {
+		requirements := byProduct[slug]
+
+		common := map[string]bool{}
+		for _, version := range requirements[0].Versions {
+			common[version] = true
+		}
+
+		for _, requirement := range requirements[1:] {
+			accepted := map[string]bool{}
+			for _, version := range requirement.Versions {
+				if common[version] {
+					accepted[version] = true
+				}
+			}
+			common = accepted
+		}
+
+		if len(common) == 0 {
+			conflicts = append(conflicts, Conflict{
+				ProductSlug:  slug,
+				Requirements: requirements,
+			})
+		}
+	}
+
+	return conflicts
+}
+
+// findUnsatisfied returns the requirements that match no release.
+func findUnsatisfied(g Graph) []Requirement {
+	var unsatisfied []Requirement
+	for _, requirement := range g.Requirements {
+		if len(requirement.Versions) == 0 {
+			unsatisfied = append(unsatisfied, requirement)
+		}
+	}
+	return unsatisfied
+}
@@ -0,0 +1,15 @@
+package depgraph_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+const apiPrefix = "/api/v2"
+
+func TestDepgraph(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Depgraph Suite")
+}
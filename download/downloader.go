@@ -1,6 +1,7 @@
 package download
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net"
@@ -8,7 +9,10 @@ import (
 	"os"
 	"golang.org/x/sync/errgroup"
 	"github.com/pivotal-cf/go-pivnet/logger"
+	"github.com/pivotal-cf/go-pivnet/metrics"
+	"github.com/pivotal-cf/go-pivnet/tracing"
 	"syscall"
+	"time"
 )
 
 //go:generate counterfeiter -o ./fakes/ranger.go --fake-name Ranger . ranger
@@ -25,6 +29,19 @@ type downloadLinkFetcher interface {
 	NewDownloadLink() (string, error)
 }
 
+// contextLinkFetcher is a downloadLinkFetcher whose requests can be
+// cancelled and traced with the context of the download.
+type contextLinkFetcher interface {
+	NewDownloadLinkWithContext(ctx context.Context) (string, error)
+}
+
+func newDownloadLink(ctx context.Context, fetcher downloadLinkFetcher) (string, error) {
+	if f, ok := fetcher.(contextLinkFetcher); ok {
+		return f.NewDownloadLinkWithContext(ctx)
+	}
+	return fetcher.NewDownloadLink()
+}
+
 //go:generate counterfeiter -o ./fakes/bar.go --fake-name Bar . bar
 type bar interface {
 	SetTotal(contentLength int64)
@@ -35,11 +52,21 @@ type bar interface {
 	NewProxyReader(reader io.Reader) io.Reader
 }
 
+// SyntheticBodyHeader marks responses whose body is not the content of
+// the file, such as downloads replayed by the cassette package.
+const SyntheticBodyHeader = "X-Pivnet-Synthetic-Body"
+
 type Client struct {
 	HTTPClient httpClient
 	Ranger     ranger
 	Bar        bar
 	Logger     logger.Logger
+	Metrics    metrics.Observer
+	Tracer     tracing.Tracer
+
+	// Synthetic is called when the file is marked with
+	// SyntheticBodyHeader, so its contents cannot be verified.
+	Synthetic func()
 }
 
 func (c Client) Get(
@@ -47,7 +74,18 @@ func (c Client) Get(
 	downloadLinkFetcher downloadLinkFetcher,
 	progressWriter io.Writer,
 ) error {
-	contentURL, err := downloadLinkFetcher.NewDownloadLink()
+	return c.GetWithContext(context.Background(), location, downloadLinkFetcher, progressWriter)
+}
+
+// GetWithContext is Get with a context holding the parent of the spans
+// for each byte range.
+func (c Client) GetWithContext(
+	ctx context.Context,
+	location *os.File,
+	downloadLinkFetcher downloadLinkFetcher,
+	progressWriter io.Writer,
+) error {
+	contentURL, err := newDownloadLink(ctx, downloadLinkFetcher)
 	if err != nil {
 		return err
 	}
@@ -57,6 +95,9 @@ func (c Client) Get(
 		return fmt.Errorf("failed to construct HEAD request: %s", err)
 	}
 
+	req = req.WithContext(ctx)
+	c.tracer().Inject(ctx, req.Header)
+
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to make HEAD request: %s", err)
@@ -64,6 +105,10 @@ func (c Client) Get(
 
 	contentURL = resp.Request.URL.String()
 
+	if resp.Header.Get(SyntheticBodyHeader) != "" && c.Synthetic != nil {
+		c.Synthetic()
+	}
+
 	ranges, err := c.Ranger.BuildRange(resp.ContentLength)
 	if err != nil {
 		return fmt.Errorf("failed to construct range: %s", err)
@@ -89,7 +134,7 @@ func (c Client) Get(
 		}
 
 		g.Go(func() error {
-			err := c.retryableRequest(contentURL, byteRange.HTTPHeader, fileWriter, byteRange.Lower, downloadLinkFetcher)
+			err := c.downloadRange(ctx, contentURL, byteRange, fileWriter, downloadLinkFetcher)
 			if err != nil {
 				return fmt.Errorf("failed during retryable request: %s", err)
 			}
@@ -105,50 +150,72 @@ func (c Client) Get(
 	return nil
 }
 
-func (c Client) retryableRequest(contentURL string, rangeHeader http.Header, fileWriter *os.File, startingByte int64, downloadLinkFetcher downloadLinkFetcher) (error) {
+func (c Client) downloadRange(ctx context.Context, contentURL string, byteRange Range, fileWriter *os.File, downloadLinkFetcher downloadLinkFetcher) error {
+	ctx, span := c.tracer().Start(ctx, "download range",
+		tracing.String(tracing.ByteRange, byteRange.HTTPHeader.Get("Range")),
+	)
+	defer span.End()
+
+	retries, err := c.retryableRequest(ctx, contentURL, byteRange.HTTPHeader, fileWriter, byteRange.Lower, downloadLinkFetcher)
+	span.SetAttributes(tracing.Int(tracing.RetryCount, retries))
+	if err != nil {
+		span.RecordError(err)
+	}
+
+	return err
+}
+
+func (c Client) retryableRequest(ctx context.Context, contentURL string, rangeHeader http.Header, fileWriter *os.File, startingByte int64, downloadLinkFetcher downloadLinkFetcher) (int, error) {
 	currentURL := contentURL
 	defer fileWriter.Close()
 
 	var err error
+	retries := -1
 Retry:
+	retries++
+	start := time.Now()
 	_, err = fileWriter.Seek(startingByte, 0)
 	if err != nil {
-		return fmt.Errorf("failed to seek to correct byte of output file: %s", err)
+		return retries, fmt.Errorf("failed to seek to correct byte of output file: %s", err)
 	}
 
 	req, err := http.NewRequest("GET", currentURL, nil)
 	if err != nil {
-		return err
+		return retries, err
 	}
 
-	req.Header = rangeHeader
+	req = req.WithContext(ctx)
+	req.Header = rangeHeader.Clone()
+	c.tracer().Inject(ctx, req.Header)
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
 		if netErr, ok := err.(net.Error); ok {
 			if netErr.Temporary() {
+				c.retry(metrics.RetryNetworkError, rangeHeader)
 				goto Retry
 			}
 		}
 
-		return fmt.Errorf("download request failed: %s", err)
+		return retries, fmt.Errorf("download request failed: %s", err)
 	}
 
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusForbidden {
 		c.Logger.Debug("received unsuccessful status code: %d", logger.Data{"statusCode": resp.StatusCode})
-		currentURL, err = downloadLinkFetcher.NewDownloadLink()
+		currentURL, err = newDownloadLink(ctx, downloadLinkFetcher)
 		if err != nil {
-			return err
+			return retries, err
 		}
 		c.Logger.Debug("fetched new download url: %d", logger.Data{"url": currentURL})
+		c.observer().LinkRefresh()
 
 		goto Retry
 	}
 
 	if resp.StatusCode != http.StatusPartialContent {
-		return fmt.Errorf("during GET unexpected status code was returned: %d", resp.StatusCode)
+		return retries, fmt.Errorf("during GET unexpected status code was returned: %d", resp.StatusCode)
 	}
 
 	var proxyReader io.Reader
@@ -158,15 +225,44 @@ Retry:
 	if err != nil {
 		if err == io.ErrUnexpectedEOF {
 			c.Bar.Add(int(-1 * bytesWritten))
+			c.retry(metrics.RetryUnexpectedEOF, rangeHeader)
 			goto Retry
 		}
 		operr, _ := err.(*net.OpError)
 		if operr.Err.Error() == syscall.ECONNRESET.Error() {
 			c.Bar.Add(int(-1 * bytesWritten))
+			c.retry(metrics.RetryConnectionReset, rangeHeader)
 			goto Retry
 		}
-		return fmt.Errorf("failed to write file during io.Copy: %s", err)
+		return retries, fmt.Errorf("failed to write file during io.Copy: %s", err)
 	}
 
-	return nil
+	c.observer().RangeDownloaded(bytesWritten, time.Since(start))
+
+	return retries, nil
+}
+
+func (c Client) retry(reason string, rangeHeader http.Header) {
+	c.observer().Retry(reason)
+
+	if c.Logger != nil {
+		logger.Warn(c.Logger, "Retrying download range", logger.Data{
+			"reason": reason,
+			"range":  rangeHeader.Get("Range"),
+		})
+	}
+}
+
+func (c Client) tracer() tracing.Tracer {
+	if c.Tracer == nil {
+		return tracing.Discard
+	}
+	return c.Tracer
+}
+
+func (c Client) observer() metrics.Observer {
+	if c.Metrics == nil {
+		return metrics.Discard
+	}
+	return c.Metrics
 }
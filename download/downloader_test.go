@@ -1,6 +1,7 @@
 package download_test
 
 import (
+	"bytes"
 	"errors"
 	"io"
 	"io/ioutil"
@@ -11,12 +12,12 @@ import (
 
 	"github.com/pivotal-cf/go-pivnet/download"
 	"github.com/pivotal-cf/go-pivnet/download/fakes"
+	"github.com/pivotal-cf/go-pivnet/metrics"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"net"
 	"syscall"
-	"fmt"
 )
 
 type EOFReader struct{}
@@ -28,7 +29,7 @@ func (e EOFReader) Read(p []byte) (int, error) {
 type ConnectionResetReader struct{}
 
 func (e ConnectionResetReader) Read(p []byte) (int, error) {
-	return 0, &net.OpError{Err: fmt.Errorf(syscall.ECONNRESET.Error())}
+	return 0, &net.OpError{Err: errors.New(syscall.ECONNRESET.Error())}
 }
 
 type NetError struct {
@@ -191,10 +192,12 @@ var _ = Describe("Downloader", func() {
 
 				ranger.BuildRangeReturns([]download.Range{{Lower: 0, Upper: 15}}, nil)
 
+				collector := metrics.NewCollector()
 				downloader := download.Client{
 					HTTPClient: httpClient,
 					Ranger:     ranger,
 					Bar:        bar,
+					Metrics:    collector,
 				}
 
 				tmpFile, err := ioutil.TempFile("", "")
@@ -209,6 +212,11 @@ var _ = Describe("Downloader", func() {
 				Expect(stats.Size()).To(BeNumerically(">", 0))
 				Expect(bar.AddArgsForCall(0)).To(Equal(-4))
 
+				output := &bytes.Buffer{}
+				Expect(collector.Write(output)).To(Succeed())
+				Expect(output.String()).To(ContainSubstring(`pivnet_download_retries_total{reason="unexpected_eof"} 1`))
+				Expect(output.String()).To(ContainSubstring("pivnet_download_bytes_total 9\n"))
+
 				content, err := ioutil.ReadAll(tmpFile)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -0,0 +1,16 @@
+package pivnet
+
+// EnsureAction reports what an Ensure call did to reach the desired state.
+type EnsureAction string
+
+const (
+	EnsureActionUnchanged EnsureAction = "unchanged"
+	EnsureActionUpdated   EnsureAction = "updated"
+	EnsureActionCreated   EnsureAction = "created"
+)
+
+func setIfNotEmpty(field *string, value string) {
+	if value != "" {
+		*field = value
+	}
+}
@@ -77,3 +77,84 @@ func newErrUnavailableForLegalReasons(message string) ErrUnavailableForLegalReas
 		Message:      message,
 	}
 }
+
+type ErrConflict struct {
+	ResponseCode int    `json:"response_code" yaml:"response_code"`
+	Message      string `json:"message" yaml:"message"`
+}
+
+func (e ErrConflict) Error() string {
+	return e.Message
+}
+
+func newErrConflict(message string) ErrConflict {
+	return ErrConflict{
+		ResponseCode: http.StatusConflict,
+		Message:      message,
+	}
+}
+
+type FieldError struct {
+	Field   string `json:"field" yaml:"field"`
+	Message string `json:"message" yaml:"message"`
+}
+
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+type ErrValidation struct {
+	Errors []FieldError `json:"errors" yaml:"errors"`
+
+	// LookupErr is set when a lookup needed to check some fields failed,
+	// so further fields may be invalid.
+	LookupErr error `json:"-" yaml:"-"`
+}
+
+func (e ErrValidation) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, fieldErr := range e.Errors {
+		messages[i] = fieldErr.Error()
+	}
+
+	message := fmt.Sprintf("validation failed: %s", strings.Join(messages, "; "))
+	if e.LookupErr != nil {
+		message = fmt.Sprintf("%s; could not complete validation: %s", message, e.LookupErr)
+	}
+
+	return message
+}
+
+func (e ErrValidation) Unwrap() error {
+	return e.LookupErr
+}
+
+type ErrFileTransferFailed struct {
+	ProductFileID int    `json:"product_file_id" yaml:"product_file_id"`
+	Name          string `json:"name" yaml:"name"`
+	Status        string `json:"status" yaml:"status"`
+}
+
+func (e ErrFileTransferFailed) Error() string {
+	return fmt.Sprintf(
+		"transfer of product file '%s' (%d) failed with status '%s'",
+		e.Name,
+		e.ProductFileID,
+		e.Status,
+	)
+}
+
+type ErrChecksumMismatch struct {
+	ProductFileID int    `json:"product_file_id" yaml:"product_file_id"`
+	Expected      string `json:"expected" yaml:"expected"`
+	Actual        string `json:"actual" yaml:"actual"`
+}
+
+func (e ErrChecksumMismatch) Error() string {
+	return fmt.Sprintf(
+		"downloaded product file %d has SHA256 '%s', expected '%s'",
+		e.ProductFileID,
+		e.Actual,
+		e.Expected,
+	)
+}
@@ -1,10 +1,14 @@
 package pivnet
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
+
+	"github.com/pivotal-cf/go-pivnet/tracing"
 )
 
 type EULAsService struct {
@@ -30,33 +34,46 @@ type EULAAcceptanceResponse struct {
 }
 
 func (e EULAsService) List() ([]EULA, error) {
-	url := "/eulas"
+	return e.ListWithContext(context.Background())
+}
 
-	var response EULAsResponse
-	resp, err := e.client.MakeRequest(
-		"GET",
-		url,
-		http.StatusOK,
-		nil,
-	)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+func (e EULAsService) ListWithContext(ctx context.Context) ([]EULA, error) {
+	ctx, span := e.client.startSpan(ctx, "EULAs.List")
+	defer span.End()
 
-	err = json.NewDecoder(resp.Body).Decode(&response)
+	url := "/eulas"
+
+	var eulas []EULA
+	err := e.client.listPages(ctx, url, func(body io.Reader) (*Links, error) {
+		var response EULAsResponse
+		err := json.NewDecoder(body).Decode(&response)
+		if err != nil {
+			return nil, err
+		}
+
+		eulas = append(eulas, response.EULAs...)
+		return response.Links, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return response.EULAs, nil
+	return eulas, nil
 }
 
 func (e EULAsService) Get(eulaSlug string) (EULA, error) {
+	return e.GetWithContext(context.Background(), eulaSlug)
+}
+
+func (e EULAsService) GetWithContext(ctx context.Context, eulaSlug string) (EULA, error) {
+	ctx, span := e.client.startSpan(ctx, "EULAs.Get", tracing.String(tracing.EULASlug, eulaSlug))
+	defer span.End()
+
 	url := fmt.Sprintf("/eulas/%s", eulaSlug)
 
 	var response EULA
-	resp, err := e.client.MakeRequest(
+	resp, err := e.client.MakeRequestWithContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -76,13 +93,24 @@ func (e EULAsService) Get(eulaSlug string) (EULA, error) {
 }
 
 func (e EULAsService) Accept(productSlug string, releaseID int) error {
+	return e.AcceptWithContext(context.Background(), productSlug, releaseID)
+}
+
+func (e EULAsService) AcceptWithContext(ctx context.Context, productSlug string, releaseID int) error {
+	ctx, span := e.client.startSpan(ctx, "EULAs.Accept",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/eula_acceptance",
 		productSlug,
 		releaseID,
 	)
 
-	resp, err := e.client.MakeRequest(
+	resp, err := e.client.MakeRequestWithContext(
+		ctx,
 		"POST",
 		url,
 		http.StatusOK,
@@ -0,0 +1,267 @@
+package pivnet
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultFailureThreshold = 3
+	defaultCooldown         = 30 * time.Second
+)
+
+// FailoverConfig configures the circuit breakers used when
+// ClientConfig.Hosts lists more than one host.
+type FailoverConfig struct {
+	// FailureThreshold is the number of consecutive failures after which
+	// a host is skipped. It defaults to 3.
+	FailureThreshold int
+
+	// Cooldown is how long a failing host is skipped before it is health
+	// checked again. It defaults to 30 seconds.
+	Cooldown time.Duration
+
+	// HealthCheckPath is requested to check that a failing host has
+	// recovered. It defaults to the API root.
+	HealthCheckPath string
+}
+
+func (c FailoverConfig) withDefaults() FailoverConfig {
+	if c.FailureThreshold <= 0 {
+		c.FailureThreshold = defaultFailureThreshold
+	}
+	if c.Cooldown <= 0 {
+		c.Cooldown = defaultCooldown
+	}
+	if c.HealthCheckPath == "" {
+		c.HealthCheckPath = apiVersion
+	}
+	return c
+}
+
+type readOnlyKey struct{}
+
+// withReadOnly marks requests that do not modify anything, though their
+// method does, so they may be sent to any healthy host.
+func withReadOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readOnlyKey{}, true)
+}
+
+// failoverTransport sends reads to the first healthy host, and writes to
+// the first host, which is the primary. Requests are addressed to the
+// primary and rewritten for other hosts. Other requests, such as
+// downloads, are passed through.
+type failoverTransport struct {
+	hosts    []*url.URL
+	circuits []*circuit
+	config   FailoverConfig
+	next     http.RoundTripper
+}
+
+func newFailoverTransport(hosts []string, config FailoverConfig, next http.RoundTripper) *failoverTransport {
+	t := &failoverTransport{
+		config: config.withDefaults(),
+		next:   next,
+	}
+
+	for _, host := range hosts {
+		u, err := url.Parse(strings.TrimSuffix(host, "/"))
+		if err != nil {
+			u = &url.URL{Path: host}
+		}
+
+		t.hosts = append(t.hosts, u)
+		t.circuits = append(t.circuits, &circuit{})
+	}
+
+	return t
+}
+
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !sameHost(req.URL, t.hosts[0]) {
+		return t.next.RoundTrip(req)
+	}
+
+	if !isRead(req) {
+		resp, err := t.next.RoundTrip(req)
+		t.circuits[0].record(succeeded(resp, err), t.config)
+		return resp, err
+	}
+
+	var (
+		resp *http.Response
+		err  error
+		sent bool
+	)
+
+	for i, host := range t.hosts {
+		if !t.available(i) {
+			continue
+		}
+
+		if sent {
+			retry, ok := rewind(req)
+			if !ok {
+				break
+			}
+			req = retry
+
+			if resp != nil {
+				resp.Body.Close()
+			}
+		}
+
+		resp, err = t.next.RoundTrip(t.rewrite(req, host))
+		sent = true
+
+		ok := succeeded(resp, err)
+		t.circuits[i].record(ok, t.config)
+		if ok {
+			return resp, nil
+		}
+	}
+
+	if !sent {
+		// Every host is failing, so report the primary's error
+		return t.next.RoundTrip(req)
+	}
+
+	return resp, err
+}
+
+// available reports whether host i may be sent a request, health
+// checking it if it has been failing for longer than the cooldown. Only
+// one request health checks a host at a time; others skip it meanwhile.
+func (t *failoverTransport) available(i int) bool {
+	switch t.circuits[i].state(t.config) {
+	case circuitClosed:
+		return true
+	case circuitHalfOpen:
+		healthy := t.healthCheck(t.hosts[i])
+		t.circuits[i].record(healthy, t.config)
+		return healthy
+	default:
+		return false
+	}
+}
+
+func (t *failoverTransport) healthCheck(host *url.URL) bool {
+	req, err := http.NewRequest("GET", host.String()+t.config.HealthCheckPath, nil)
+	if err != nil {
+		return false
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+
+	return resp.StatusCode < http.StatusInternalServerError
+}
+
+func (t *failoverTransport) rewrite(req *http.Request, host *url.URL) *http.Request {
+	primary := t.hosts[0]
+	if host == primary {
+		return req
+	}
+
+	req = req.Clone(req.Context())
+	req.URL.Scheme = host.Scheme
+	req.URL.Host = host.Host
+	req.URL.Path = host.Path + strings.TrimPrefix(req.URL.Path, primary.Path)
+	req.URL.RawPath = ""
+	req.Host = ""
+	return req
+}
+
+// sameHost reports whether u is on host, comparing the scheme and host
+// but not the path.
+func sameHost(u *url.URL, host *url.URL) bool {
+	return strings.EqualFold(u.Scheme, host.Scheme) && strings.EqualFold(u.Host, host.Host)
+}
+
+// rewind returns a copy of req that can be sent again.
+func rewind(req *http.Request) (*http.Request, bool) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, true
+	}
+	if req.GetBody == nil {
+		return nil, false
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, false
+	}
+
+	req = req.Clone(req.Context())
+	req.Body = body
+	return req, true
+}
+
+func isRead(req *http.Request) bool {
+	if readOnly, _ := req.Context().Value(readOnlyKey{}).(bool); readOnly {
+		return true
+	}
+	return !isMutating(req.Method)
+}
+
+// succeeded reports whether a host answered, treating server errors as
+// failures.
+func succeeded(resp *http.Response, err error) bool {
+	return err == nil && resp.StatusCode < http.StatusInternalServerError
+}
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+type circuit struct {
+	mutex    sync.Mutex
+	failures int
+	openedAt time.Time
+	checking bool
+}
+
+// state returns circuitHalfOpen to only one caller until the result of
+// its health check is recorded, and circuitOpen to the others.
+func (c *circuit) state(config FailoverConfig) circuitState {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.failures < config.FailureThreshold {
+		return circuitClosed
+	}
+	if c.checking || time.Since(c.openedAt) < config.Cooldown {
+		return circuitOpen
+	}
+
+	c.checking = true
+	return circuitHalfOpen
+}
+
+func (c *circuit) record(ok bool, config FailoverConfig) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.checking = false
+
+	if ok {
+		c.failures = 0
+		return
+	}
+
+	c.failures++
+	if c.failures >= config.FailureThreshold {
+		c.openedAt = time.Now()
+	}
+}
@@ -0,0 +1,187 @@
+package pivnet_test
+
+import (
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/onsi/gomega/ghttp"
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/logger/loggerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PivnetClient - failover", func() {
+	var (
+		primary *ghttp.Server
+		mirror  *ghttp.Server
+		client  pivnet.Client
+
+		primaryStatus int
+	)
+
+	product := func(name string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"id":1,"slug":"some-product","name":"` + name + `"}`))
+		}
+	}
+
+	BeforeEach(func() {
+		primaryStatus = http.StatusServiceUnavailable
+
+		primary = ghttp.NewServer()
+		primary.AllowUnhandledRequests = true
+		primary.RouteToHandler("GET", apiPrefix+"/products/some-product", func(w http.ResponseWriter, r *http.Request) {
+			if primaryStatus != http.StatusOK {
+				w.WriteHeader(primaryStatus)
+				return
+			}
+			product("primary")(w, r)
+		})
+		primary.RouteToHandler("GET", apiPrefix, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(primaryStatus)
+		})
+		primary.RouteToHandler("PATCH", apiPrefix+"/products/some-product/releases/3",
+			ghttp.RespondWith(http.StatusOK, `{"release":{"id":3}}`))
+
+		mirror = ghttp.NewServer()
+		mirror.AllowUnhandledRequests = true
+		mirror.RouteToHandler("GET", apiPrefix+"/products/some-product", product("mirror"))
+
+		client = pivnet.NewClient(pivnet.ClientConfig{
+			Hosts: []string{primary.URL(), mirror.URL()},
+			Token: "my-auth-token",
+			Failover: pivnet.FailoverConfig{
+				FailureThreshold: 2,
+				Cooldown:         50 * time.Millisecond,
+			},
+		}, &loggerfakes.FakeLogger{})
+	})
+
+	AfterEach(func() {
+		primary.Close()
+		mirror.Close()
+	})
+
+	getProductName := func() string {
+		product, err := client.Products.Get("some-product")
+		Expect(err).NotTo(HaveOccurred())
+		return product.Name
+	}
+
+	requestsTo := func(server *ghttp.Server, path string) int {
+		count := 0
+		for _, r := range server.ReceivedRequests() {
+			if r.URL.Path == path {
+				count++
+			}
+		}
+		return count
+	}
+
+	It("sends reads to the primary while it is healthy", func() {
+		primaryStatus = http.StatusOK
+
+		Expect(getProductName()).To(Equal("primary"))
+		Expect(mirror.ReceivedRequests()).To(BeEmpty())
+	})
+
+	It("fails reads over to the next host", func() {
+		Expect(getProductName()).To(Equal("mirror"))
+	})
+
+	It("skips the primary once its circuit opens, until it passes a health check", func() {
+		productPath := apiPrefix + "/products/some-product"
+
+		getProductName()
+		getProductName()
+		Expect(requestsTo(primary, productPath)).To(Equal(2))
+
+		Expect(getProductName()).To(Equal("mirror"))
+		Expect(requestsTo(primary, productPath)).To(Equal(2))
+
+		primaryStatus = http.StatusOK
+		time.Sleep(60 * time.Millisecond)
+
+		Expect(getProductName()).To(Equal("primary"))
+		Expect(requestsTo(primary, apiPrefix)).To(Equal(1))
+	})
+
+	It("health checks a host once while concurrent requests skip it", func() {
+		getProductName()
+		getProductName()
+
+		primary.RouteToHandler("GET", apiPrefix, func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(50 * time.Millisecond)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+		time.Sleep(60 * time.Millisecond)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+
+				product, err := client.Products.Get("some-product")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(product.Name).To(Equal("mirror"))
+			}()
+		}
+		wg.Wait()
+
+		Expect(requestsTo(primary, apiPrefix)).To(Equal(1))
+	})
+
+	It("runs middleware on requests addressed to the primary before rewriting them", func() {
+		primaryURL, err := url.Parse(primary.URL())
+		Expect(err).NotTo(HaveOccurred())
+
+		// Like the access token middleware, only authenticates API requests
+		authenticate := func(next http.RoundTripper) http.RoundTripper {
+			return pivnet.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				if req.URL.Host == primaryURL.Host {
+					req = req.Clone(req.Context())
+					req.Header.Set("Authorization", "Bearer some-access-token")
+				}
+				return next.RoundTrip(req)
+			})
+		}
+
+		client = pivnet.NewClient(pivnet.ClientConfig{
+			Hosts:      []string{primary.URL(), mirror.URL()},
+			Middleware: []pivnet.Middleware{authenticate},
+		}, &loggerfakes.FakeLogger{})
+
+		Expect(getProductName()).To(Equal("mirror"))
+
+		requests := mirror.ReceivedRequests()
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Header.Get("Authorization")).To(Equal("Bearer some-access-token"))
+	})
+
+	It("pins writes to the primary", func() {
+		_, err := client.Releases.Update("some-product", pivnet.Release{ID: 3})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(requestsTo(primary, apiPrefix+"/products/some-product/releases/3")).To(Equal(1))
+		Expect(mirror.ReceivedRequests()).To(BeEmpty())
+	})
+
+	It("fetches download links from the selected host", func() {
+		downloadPath := apiPrefix + "/products/some-product/releases/3/product_files/2/download"
+		primary.RouteToHandler("POST", downloadPath, ghttp.RespondWith(http.StatusServiceUnavailable, nil))
+		mirror.RouteToHandler("POST", downloadPath, ghttp.RespondWith(http.StatusFound, nil, http.Header{
+			"Location": {"https://mirror.example.com/some-file"},
+		}))
+
+		fetcher := pivnet.NewProductFileLinkFetcher(primary.URL()+downloadPath, client)
+		link, err := fetcher.NewDownloadLink()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(link).To(Equal("https://mirror.example.com/some-file"))
+	})
+})
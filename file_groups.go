@@ -2,9 +2,13 @@ package pivnet
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+
+	"github.com/pivotal-cf/go-pivnet/tracing"
 )
 
 type FileGroupsService struct {
@@ -48,37 +52,59 @@ type FileGroupsResponse struct {
 	FileGroups []FileGroup `json:"file_groups,omitempty"`
 }
 
+// fileGroupsPage decodes a FileGroupsResponse and the link to its next page.
+type fileGroupsPage struct {
+	FileGroupsResponse
+	Links *Links `json:"_links,omitempty"`
+}
+
 func (e FileGroupsService) List(productSlug string) ([]FileGroup, error) {
-	url := fmt.Sprintf("/products/%s/file_groups", productSlug)
+	return e.ListWithContext(context.Background(), productSlug)
+}
 
-	var response FileGroupsResponse
-	resp, err := e.client.MakeRequest(
-		"GET",
-		url,
-		http.StatusOK,
-		nil,
-	)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+func (e FileGroupsService) ListWithContext(ctx context.Context, productSlug string) ([]FileGroup, error) {
+	ctx, span := e.client.startSpan(ctx, "FileGroups.List", tracing.String(tracing.ProductSlug, productSlug))
+	defer span.End()
 
-	err = json.NewDecoder(resp.Body).Decode(&response)
+	url := fmt.Sprintf("/products/%s/file_groups", productSlug)
+
+	var fileGroups []FileGroup
+	err := e.client.listPages(ctx, url, func(body io.Reader) (*Links, error) {
+		var response fileGroupsPage
+		err := json.NewDecoder(body).Decode(&response)
+		if err != nil {
+			return nil, err
+		}
+
+		fileGroups = append(fileGroups, response.FileGroups...)
+		return response.Links, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return response.FileGroups, nil
+	return fileGroups, nil
 }
 
 func (p FileGroupsService) Get(productSlug string, fileGroupID int) (FileGroup, error) {
+	return p.GetWithContext(context.Background(), productSlug, fileGroupID)
+}
+
+func (p FileGroupsService) GetWithContext(ctx context.Context, productSlug string, fileGroupID int) (FileGroup, error) {
+	ctx, span := p.client.startSpan(ctx, "FileGroups.Get",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.FileGroupID, fileGroupID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf("/products/%s/file_groups/%d",
 		productSlug,
 		fileGroupID,
 	)
 
 	var response FileGroup
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestWithContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -98,6 +124,15 @@ func (p FileGroupsService) Get(productSlug string, fileGroupID int) (FileGroup,
 }
 
 func (p FileGroupsService) Create(config CreateFileGroupConfig) (FileGroup, error) {
+	return p.CreateWithContext(context.Background(), config)
+}
+
+func (p FileGroupsService) CreateWithContext(ctx context.Context, config CreateFileGroupConfig) (FileGroup, error) {
+	ctx, span := p.client.startSpan(ctx, "FileGroups.Create",
+		tracing.String(tracing.ProductSlug, config.ProductSlug),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/file_groups",
 		config.ProductSlug,
@@ -119,7 +154,8 @@ func (p FileGroupsService) Create(config CreateFileGroupConfig) (FileGroup, erro
 	body := bytes.NewReader(b)
 
 	var response FileGroup
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestWithContext(
+		ctx,
 		"POST",
 		url,
 		http.StatusCreated,
@@ -138,7 +174,49 @@ func (p FileGroupsService) Create(config CreateFileGroupConfig) (FileGroup, erro
 	return response, nil
 }
 
+// Ensure makes sure a file group named config.Name exists.
+// As a file group has no other mutable fields, an existing file group
+// is always returned unchanged.
+func (p FileGroupsService) Ensure(config CreateFileGroupConfig) (FileGroup, EnsureAction, error) {
+	return p.EnsureWithContext(context.Background(), config)
+}
+
+func (p FileGroupsService) EnsureWithContext(ctx context.Context, config CreateFileGroupConfig) (FileGroup, EnsureAction, error) {
+	ctx, span := p.client.startSpan(ctx, "FileGroups.Ensure",
+		tracing.String(tracing.ProductSlug, config.ProductSlug),
+	)
+	defer span.End()
+
+	fileGroups, err := p.ListWithContext(ctx, config.ProductSlug)
+	if err != nil {
+		return FileGroup{}, "", err
+	}
+
+	for _, fileGroup := range fileGroups {
+		if fileGroup.Name == config.Name {
+			return fileGroup, EnsureActionUnchanged, nil
+		}
+	}
+
+	fileGroup, err := p.CreateWithContext(ctx, config)
+	if err != nil {
+		return FileGroup{}, "", err
+	}
+
+	return fileGroup, EnsureActionCreated, nil
+}
+
 func (p FileGroupsService) Update(productSlug string, fileGroup FileGroup) (FileGroup, error) {
+	return p.UpdateWithContext(context.Background(), productSlug, fileGroup)
+}
+
+func (p FileGroupsService) UpdateWithContext(ctx context.Context, productSlug string, fileGroup FileGroup) (FileGroup, error) {
+	ctx, span := p.client.startSpan(ctx, "FileGroups.Update",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.FileGroupID, fileGroup.ID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/file_groups/%d",
 		productSlug,
@@ -161,7 +239,8 @@ func (p FileGroupsService) Update(productSlug string, fileGroup FileGroup) (File
 	body := bytes.NewReader(b)
 
 	var response FileGroup
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestWithContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusOK,
@@ -181,6 +260,16 @@ func (p FileGroupsService) Update(productSlug string, fileGroup FileGroup) (File
 }
 
 func (p FileGroupsService) Delete(productSlug string, id int) (FileGroup, error) {
+	return p.DeleteWithContext(context.Background(), productSlug, id)
+}
+
+func (p FileGroupsService) DeleteWithContext(ctx context.Context, productSlug string, id int) (FileGroup, error) {
+	ctx, span := p.client.startSpan(ctx, "FileGroups.Delete",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.FileGroupID, id),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/file_groups/%d",
 		productSlug,
@@ -188,7 +277,8 @@ func (p FileGroupsService) Delete(productSlug string, id int) (FileGroup, error)
 	)
 
 	var response FileGroup
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestWithContext(
+		ctx,
 		"DELETE",
 		url,
 		http.StatusOK,
@@ -208,29 +298,37 @@ func (p FileGroupsService) Delete(productSlug string, id int) (FileGroup, error)
 }
 
 func (p FileGroupsService) ListForRelease(productSlug string, releaseID int) ([]FileGroup, error) {
+	return p.ListForReleaseWithContext(context.Background(), productSlug, releaseID)
+}
+
+func (p FileGroupsService) ListForReleaseWithContext(ctx context.Context, productSlug string, releaseID int) ([]FileGroup, error) {
+	ctx, span := p.client.startSpan(ctx, "FileGroups.ListForRelease",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf("/products/%s/releases/%d/file_groups",
 		productSlug,
 		releaseID,
 	)
 
-	var response FileGroupsResponse
-	resp, err := p.client.MakeRequest(
-		"GET",
-		url,
-		http.StatusOK,
-		nil,
-	)
+	var fileGroups []FileGroup
+	err := p.client.listPages(ctx, url, func(body io.Reader) (*Links, error) {
+		var response fileGroupsPage
+		err := json.NewDecoder(body).Decode(&response)
+		if err != nil {
+			return nil, err
+		}
+
+		fileGroups = append(fileGroups, response.FileGroups...)
+		return response.Links, nil
+	})
 	if err != nil {
 		return []FileGroup{}, err
 	}
-	defer resp.Body.Close()
 
-	err = json.NewDecoder(resp.Body).Decode(&response)
-	if err != nil {
-		return []FileGroup{}, err
-	}
-
-	return response.FileGroups, nil
+	return fileGroups, nil
 }
 
 func (r FileGroupsService) AddToRelease(
@@ -238,6 +336,22 @@ func (r FileGroupsService) AddToRelease(
 	releaseID int,
 	fileGroupID int,
 ) error {
+	return r.AddToReleaseWithContext(context.Background(), productSlug, releaseID, fileGroupID)
+}
+
+func (r FileGroupsService) AddToReleaseWithContext(
+	ctx context.Context,
+	productSlug string,
+	releaseID int,
+	fileGroupID int,
+) error {
+	ctx, span := r.client.startSpan(ctx, "FileGroups.AddToRelease",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+		tracing.Int(tracing.FileGroupID, fileGroupID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/add_file_group",
 		productSlug,
@@ -257,7 +371,8 @@ func (r FileGroupsService) AddToRelease(
 		return err
 	}
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestWithContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
@@ -276,6 +391,22 @@ func (r FileGroupsService) RemoveFromRelease(
 	releaseID int,
 	fileGroupID int,
 ) error {
+	return r.RemoveFromReleaseWithContext(context.Background(), productSlug, releaseID, fileGroupID)
+}
+
+func (r FileGroupsService) RemoveFromReleaseWithContext(
+	ctx context.Context,
+	productSlug string,
+	releaseID int,
+	fileGroupID int,
+) error {
+	ctx, span := r.client.startSpan(ctx, "FileGroups.RemoveFromRelease",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+		tracing.Int(tracing.FileGroupID, fileGroupID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/remove_file_group",
 		productSlug,
@@ -295,7 +426,8 @@ func (r FileGroupsService) RemoveFromRelease(
 		return err
 	}
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestWithContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
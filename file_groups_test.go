@@ -47,7 +47,7 @@ var _ = Describe("PivnetClient - FileGroup", func() {
 	Describe("List", func() {
 		It("returns all FileGroups", func() {
 			response := pivnet.FileGroupsResponse{
-				[]pivnet.FileGroup{
+				FileGroups: []pivnet.FileGroup{
 					{
 						ID:   1234,
 						Name: "Some file group",
@@ -384,6 +384,55 @@ var _ = Describe("PivnetClient - FileGroup", func() {
 		})
 	})
 
+	Describe("Ensure", func() {
+		var (
+			fileGroupsURL string
+		)
+
+		BeforeEach(func() {
+			fileGroupsURL = fmt.Sprintf("%s/products/%s/file_groups", apiPrefix, productSlug)
+		})
+
+		It("returns an existing file group with the same name", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", fileGroupsURL),
+					ghttp.RespondWith(http.StatusOK, `{"file_groups":[{"id":1234,"name":"some-name"}]}`),
+				),
+			)
+
+			fileGroup, action, err := client.FileGroups.Ensure(pivnet.CreateFileGroupConfig{
+				ProductSlug: productSlug,
+				Name:        "some-name",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(action).To(Equal(pivnet.EnsureActionUnchanged))
+			Expect(fileGroup.ID).To(Equal(1234))
+		})
+
+		It("creates the file group when none has the name", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", fileGroupsURL),
+					ghttp.RespondWith(http.StatusOK, `{"file_groups":[{"id":1234,"name":"other-name"}]}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", fileGroupsURL),
+					ghttp.VerifyJSON(`{"file_group":{"name":"some-name"}}`),
+					ghttp.RespondWith(http.StatusCreated, `{"id":2345,"name":"some-name"}`),
+				),
+			)
+
+			fileGroup, action, err := client.FileGroups.Ensure(pivnet.CreateFileGroupConfig{
+				ProductSlug: productSlug,
+				Name:        "some-name",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(action).To(Equal(pivnet.EnsureActionCreated))
+			Expect(fileGroup.ID).To(Equal(2345))
+		})
+	})
+
 	Describe("Update", func() {
 		var (
 			fileGroup pivnet.FileGroup
@@ -0,0 +1,191 @@
+// Package index answers reverse dependency and upgrade path queries,
+// which the Pivotal Network API only answers in the forward direction.
+package index
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/logger"
+	"golang.org/x/sync/errgroup"
+)
+
+const defaultConcurrency = 4
+
+type Builder struct {
+	client      pivnet.Client
+	logger      logger.Logger
+	concurrency int
+}
+
+// NewBuilder returns a Builder that makes at most concurrency requests
+// at once. A concurrency of zero or less uses a default.
+func NewBuilder(client pivnet.Client, logger logger.Logger, concurrency int) Builder {
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+
+	return Builder{
+		client:      client,
+		logger:      logger,
+		concurrency: concurrency,
+	}
+}
+
+// Build scans the dependencies, dependency specifiers and upgrade paths
+// of every release of the given products.
+func (b Builder) Build(productSlugs []string) (Index, error) {
+	return b.BuildWithContext(context.Background(), productSlugs)
+}
+
+// BuildWithContext is Build with a context cancelling its requests. The
+// first request to fail cancels the others.
+func (b Builder) BuildWithContext(ctx context.Context, productSlugs []string) (Index, error) {
+	var (
+		mutex sync.Mutex
+		index = Index{Products: productSlugs, BuiltAt: time.Now().UTC()}
+	)
+
+	releases := make([][]pivnet.Release, len(productSlugs))
+	err := b.run(ctx, len(productSlugs), func(ctx context.Context, i int) error {
+		productReleases, err := b.client.Releases.ListWithContext(ctx, productSlugs[i])
+		if err != nil {
+			return err
+		}
+
+		releases[i] = productReleases
+		return nil
+	})
+	if err != nil {
+		return Index{}, err
+	}
+
+	var scans []func(ctx context.Context) error
+	for i, productSlug := range productSlugs {
+		for _, release := range releases[i] {
+			productSlug, release := productSlug, release
+
+			from := Ref{
+				ProductSlug: productSlug,
+				ReleaseID:   release.ID,
+				Version:     release.Version,
+			}
+
+			scans = append(scans, func(ctx context.Context) error {
+				b.logger.Debug("Indexing release", logger.Data{"product_slug": productSlug, "version": release.Version, "scan": "dependencies"})
+
+				dependencies, err := b.client.ReleaseDependencies.ListWithContext(ctx, productSlug, release.ID)
+				if err != nil {
+					return err
+				}
+
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				for _, dependency := range dependencies {
+					index.Dependencies = append(index.Dependencies, Dependency{
+						From: from,
+						To: Ref{
+							ProductSlug: dependency.Release.Product.Slug,
+							ReleaseID:   dependency.Release.ID,
+							Version:     dependency.Release.Version,
+						},
+					})
+				}
+				return nil
+			})
+
+			scans = append(scans, func(ctx context.Context) error {
+				b.logger.Debug("Indexing release", logger.Data{"product_slug": productSlug, "version": release.Version, "scan": "dependency_specifiers"})
+
+				dependencySpecifiers, err := b.client.DependencySpecifiers.ListWithContext(ctx, productSlug, release.ID)
+				if err != nil {
+					return err
+				}
+
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				for _, dependencySpecifier := range dependencySpecifiers {
+					index.DependencySpecifiers = append(index.DependencySpecifiers, DependencySpecifier{
+						From:        from,
+						ProductSlug: dependencySpecifier.Product.Slug,
+						Specifier:   dependencySpecifier.Specifier,
+					})
+				}
+				return nil
+			})
+
+			scans = append(scans, func(ctx context.Context) error {
+				b.logger.Debug("Indexing release", logger.Data{"product_slug": productSlug, "version": release.Version, "scan": "upgrade_paths"})
+
+				upgradePaths, err := b.client.ReleaseUpgradePaths.GetWithContext(ctx, productSlug, release.ID)
+				if err != nil {
+					return err
+				}
+
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				for _, upgradePath := range upgradePaths {
+					index.UpgradePaths = append(index.UpgradePaths, UpgradePath{
+						From: Ref{
+							ProductSlug: productSlug,
+							ReleaseID:   upgradePath.Release.ID,
+							Version:     upgradePath.Release.Version,
+						},
+						To: from,
+					})
+				}
+				return nil
+			})
+		}
+	}
+
+	err = b.run(ctx, len(scans), func(ctx context.Context, i int) error {
+		return scans[i](ctx)
+	})
+	if err != nil {
+		return Index{}, err
+	}
+
+	index.sort()
+
+	return index, nil
+}
+
+// run calls job with each index below n from b.concurrency goroutines,
+// stopping at the first error.
+func (b Builder) run(ctx context.Context, n int, job func(ctx context.Context, i int) error) error {
+	g, ctx := errgroup.WithContext(ctx)
+
+	jobs := make(chan int)
+	g.Go(func() error {
+		defer close(jobs)
+
+		for i := 0; i < n; i++ {
+			select {
+			case jobs <- i:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return nil
+	})
+
+	for w := 0; w < b.concurrency; w++ {
+		g.Go(func() error {
+			for i := range jobs {
+				err := job(ctx, i)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+
+	return g.Wait()
+}
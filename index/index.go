@@ -0,0 +1,182 @@
+package index
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet/specifier"
+)
+
+// Index holds the forward relationships of every scanned release so
+// they can be queried in reverse.
+type Index struct {
+	Products             []string              `json:"products"`
+	BuiltAt              time.Time             `json:"built_at"`
+	Dependencies         []Dependency          `json:"dependencies"`
+	DependencySpecifiers []DependencySpecifier `json:"dependency_specifiers"`
+	UpgradePaths         []UpgradePath         `json:"upgrade_paths"`
+}
+
+type Ref struct {
+	ProductSlug string `json:"product_slug"`
+	ReleaseID   int    `json:"release_id"`
+	Version     string `json:"version"`
+}
+
+func (r Ref) String() string {
+	return fmt.Sprintf("%s@%s", r.ProductSlug, r.Version)
+}
+
+// Dependency records that From depends explicitly on To.
+type Dependency struct {
+	From Ref `json:"from"`
+	To   Ref `json:"to"`
+}
+
+// DependencySpecifier records that From depends on any release of the
+// product matching Specifier.
+type DependencySpecifier struct {
+	From        Ref    `json:"from"`
+	ProductSlug string `json:"product_slug"`
+	Specifier   string `json:"specifier"`
+}
+
+// UpgradePath records that To can be upgraded to from From.
+type UpgradePath struct {
+	From Ref `json:"from"`
+	To   Ref `json:"to"`
+}
+
+// Dependent is a release depending on the queried release, either
+// explicitly or through Specifier.
+type Dependent struct {
+	Release   Ref    `json:"release"`
+	Specifier string `json:"specifier,omitempty"`
+}
+
+// Dependents returns the releases depending on the given release.
+// Specifiers that cannot be parsed are ignored.
+func (i Index) Dependents(productSlug string, version string) []Dependent {
+	var dependents []Dependent
+
+	for _, dependency := range i.Dependencies {
+		if dependency.To.ProductSlug == productSlug && dependency.To.Version == version {
+			dependents = append(dependents, Dependent{Release: dependency.From})
+		}
+	}
+
+	for _, dependencySpecifier := range i.DependencySpecifiers {
+		if dependencySpecifier.ProductSlug != productSlug {
+			continue
+		}
+
+		spec, err := specifier.Parse(dependencySpecifier.Specifier)
+		if err != nil || !spec.Matches(version) {
+			continue
+		}
+
+		dependents = append(dependents, Dependent{
+			Release:   dependencySpecifier.From,
+			Specifier: dependencySpecifier.Specifier,
+		})
+	}
+
+	return dependents
+}
+
+// UpgradesFrom returns the releases listing the given release as one
+// they can be upgraded from.
+func (i Index) UpgradesFrom(productSlug string, version string) []Ref {
+	var releases []Ref
+	for _, upgradePath := range i.UpgradePaths {
+		if upgradePath.From.ProductSlug == productSlug && upgradePath.From.Version == version {
+			releases = append(releases, upgradePath.To)
+		}
+	}
+	return releases
+}
+
+// Stale reports whether the index was built more than maxAge ago.
+func (i Index) Stale(maxAge time.Duration) bool {
+	return time.Since(i.BuiltAt) > maxAge
+}
+
+func (i Index) Write(w io.Writer) error {
+	return json.NewEncoder(w).Encode(i)
+}
+
+func (i Index) WriteFile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	err = i.Write(f)
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func Load(r io.Reader) (Index, error) {
+	var i Index
+	err := json.NewDecoder(r).Decode(&i)
+	if err != nil {
+		return Index{}, fmt.Errorf("could not parse index: %s", err)
+	}
+	return i, nil
+}
+
+func LoadFile(path string) (Index, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Index{}, err
+	}
+	defer f.Close()
+
+	return Load(f)
+}
+
+// sort orders the index so that building it twice gives the same result,
+// whatever order the requests completed in.
+func (i *Index) sort() {
+	less := func(a Ref, b Ref) bool {
+		if a.ProductSlug != b.ProductSlug {
+			return a.ProductSlug < b.ProductSlug
+		}
+		return specifier.Compare(a.Version, b.Version) < 0
+	}
+
+	sort.SliceStable(i.Dependencies, func(a, b int) bool {
+		x, y := i.Dependencies[a], i.Dependencies[b]
+		if x.From != y.From {
+			return less(x.From, y.From)
+		}
+		return less(x.To, y.To)
+	})
+
+	sort.SliceStable(i.DependencySpecifiers, func(a, b int) bool {
+		x, y := i.DependencySpecifiers[a], i.DependencySpecifiers[b]
+		if x.From != y.From {
+			return less(x.From, y.From)
+		}
+		if x.ProductSlug != y.ProductSlug {
+			return x.ProductSlug < y.ProductSlug
+		}
+		return x.Specifier < y.Specifier
+	})
+
+	sort.SliceStable(i.UpgradePaths, func(a, b int) bool {
+		x, y := i.UpgradePaths[a], i.UpgradePaths[b]
+		if x.To != y.To {
+			return less(x.To, y.To)
+		}
+		return less(x.From, y.From)
+	})
+}
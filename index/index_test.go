@@ -0,0 +1,252 @@
+package index_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/onsi/gomega/ghttp"
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/index"
+	"github.com/pivotal-cf/go-pivnet/logger"
+	"github.com/pivotal-cf/go-pivnet/logger/loggerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Index", func() {
+	var (
+		server      *ghttp.Server
+		client      pivnet.Client
+		fakeLogger  *loggerfakes.FakeLogger
+		concurrency int
+
+		mutex    sync.Mutex
+		inFlight int
+		maxSeen  int
+	)
+
+	respond := func(body string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			inFlight++
+			if inFlight > maxSeen {
+				maxSeen = inFlight
+			}
+			mutex.Unlock()
+
+			time.Sleep(5 * time.Millisecond)
+
+			mutex.Lock()
+			inFlight--
+			mutex.Unlock()
+
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(body))
+		}
+	}
+
+	routeRelease := func(productSlug string, releaseID int, dependencies string, specifiers string, upgradePaths string) {
+		url := fmt.Sprintf("%s/products/%s/releases/%d", apiPrefix, productSlug, releaseID)
+		server.RouteToHandler("GET", url+"/dependencies",
+			respond(fmt.Sprintf(`{"dependencies":[%s]}`, dependencies)))
+		server.RouteToHandler("GET", url+"/dependency_specifiers",
+			respond(fmt.Sprintf(`{"dependency_specifiers":[%s]}`, specifiers)))
+		server.RouteToHandler("GET", url+"/upgrade_paths",
+			respond(fmt.Sprintf(`{"upgrade_paths":[%s]}`, upgradePaths)))
+	}
+
+	build := func() (index.Index, error) {
+		return index.NewBuilder(client, fakeLogger, concurrency).Build([]string{"a", "b"})
+	}
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		maxSeen = 0
+		concurrency = 2
+
+		fakeLogger = &loggerfakes.FakeLogger{}
+		client = pivnet.NewClient(pivnet.ClientConfig{
+			Host:      server.URL(),
+			Token:     "my-auth-token",
+			UserAgent: "pivnet-resource/0.1.0 (some-url)",
+		}, fakeLogger)
+
+		server.RouteToHandler("GET", fmt.Sprintf("%s/products/a/releases", apiPrefix),
+			respond(`{"releases":[{"id":1,"version":"1.0.0"},{"id":2,"version":"1.1.0"}]}`))
+		server.RouteToHandler("GET", fmt.Sprintf("%s/products/b/releases", apiPrefix),
+			respond(`{"releases":[{"id":10,"version":"2.0.0"},{"id":11,"version":"2.1.0"}]}`))
+
+		routeRelease("a", 1, "", "", "")
+		routeRelease("a", 2, "", "", `{"release":{"id":1,"version":"1.0.0"}}`)
+		routeRelease("b", 10,
+			`{"release":{"id":1,"version":"1.0.0","product":{"slug":"a"}}}`,
+			`{"id":1,"product":{"slug":"a"},"specifier":"~> 1.0"}`,
+			"",
+		)
+		routeRelease("b", 11,
+			"",
+			`{"id":2,"product":{"slug":"a"},"specifier":"1.1.*"},{"id":3,"product":{"slug":"a"},"specifier":"~> 1"}`,
+			`{"release":{"id":10,"version":"2.0.0"}}`,
+		)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("finds the releases depending on a release", func() {
+		i, err := build()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(i.Dependents("a", "1.0.0")).To(Equal([]index.Dependent{
+			{Release: index.Ref{ProductSlug: "b", ReleaseID: 10, Version: "2.0.0"}},
+			{Release: index.Ref{ProductSlug: "b", ReleaseID: 10, Version: "2.0.0"}, Specifier: "~> 1.0"},
+		}))
+
+		Expect(i.Dependents("a", "1.1.0")).To(Equal([]index.Dependent{
+			{Release: index.Ref{ProductSlug: "b", ReleaseID: 10, Version: "2.0.0"}, Specifier: "~> 1.0"},
+			{Release: index.Ref{ProductSlug: "b", ReleaseID: 11, Version: "2.1.0"}, Specifier: "1.1.*"},
+		}))
+
+		Expect(i.Dependents("b", "2.0.0")).To(BeEmpty())
+	})
+
+	It("finds the releases that can be upgraded to from a release", func() {
+		i, err := build()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(i.UpgradesFrom("a", "1.0.0")).To(Equal([]index.Ref{
+			{ProductSlug: "a", ReleaseID: 2, Version: "1.1.0"},
+		}))
+		Expect(i.UpgradesFrom("b", "2.0.0")).To(Equal([]index.Ref{
+			{ProductSlug: "b", ReleaseID: 11, Version: "2.1.0"},
+		}))
+		Expect(i.UpgradesFrom("b", "2.1.0")).To(BeEmpty())
+	})
+
+	It("makes no more than the configured number of requests at once", func() {
+		_, err := build()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(maxSeen).To(BeNumerically(">", 0))
+		Expect(maxSeen).To(BeNumerically("<=", 2))
+	})
+
+	It("builds the same index whatever order requests complete in", func() {
+		first, err := build()
+		Expect(err).NotTo(HaveOccurred())
+
+		concurrency = 8
+		second, err := build()
+		Expect(err).NotTo(HaveOccurred())
+
+		second.BuiltAt = first.BuiltAt
+		Expect(second).To(Equal(first))
+	})
+
+	Context("when a request fails", func() {
+		BeforeEach(func() {
+			server.RouteToHandler("GET", fmt.Sprintf("%s/products/b/releases/11/upgrade_paths", apiPrefix),
+				ghttp.RespondWith(http.StatusTeapot, `{"message":"foo message"}`))
+		})
+
+		It("returns the error", func() {
+			_, err := build()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("foo message"))
+		})
+	})
+
+	Context("when an early request fails", func() {
+		BeforeEach(func() {
+			concurrency = 1
+			server.RouteToHandler("GET", fmt.Sprintf("%s/products/a/releases/1/dependencies", apiPrefix),
+				ghttp.RespondWith(http.StatusTeapot, `{"message":"foo message"}`))
+		})
+
+		It("makes no further requests", func() {
+			_, err := build()
+			Expect(err).To(HaveOccurred())
+
+			Expect(server.ReceivedRequests()).To(HaveLen(3))
+		})
+
+		It("logs only the scan that ran", func() {
+			_, err := build()
+			Expect(err).To(HaveOccurred())
+
+			var scans []logger.Data
+			for i := 0; i < fakeLogger.DebugCallCount(); i++ {
+				action, data := fakeLogger.DebugArgsForCall(i)
+				if action == "Indexing release" {
+					scans = append(scans, data[0])
+				}
+			}
+			Expect(scans).To(Equal([]logger.Data{
+				{"product_slug": "a", "version": "1.0.0", "scan": "dependencies"},
+			}))
+		})
+	})
+
+	Context("when the context is cancelled", func() {
+		It("returns the context error without making requests", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			_, err := index.NewBuilder(client, fakeLogger, concurrency).BuildWithContext(ctx, []string{"a", "b"})
+			Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+
+	Describe("caching", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "go-pivnet-index")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("round trips through a file", func() {
+			i, err := build()
+			Expect(err).NotTo(HaveOccurred())
+
+			path := filepath.Join(dir, "index.json")
+			Expect(i.WriteFile(path)).To(Succeed())
+
+			loaded, err := index.LoadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(loaded.BuiltAt.Equal(i.BuiltAt)).To(BeTrue())
+			loaded.BuiltAt = i.BuiltAt
+			Expect(loaded).To(Equal(i))
+		})
+
+		It("reports when the index is stale", func() {
+			i := index.Index{BuiltAt: time.Now().Add(-2 * time.Hour)}
+
+			Expect(i.Stale(time.Hour)).To(BeTrue())
+			Expect(i.Stale(3 * time.Hour)).To(BeFalse())
+		})
+
+		It("returns an error for an invalid index", func() {
+			_, err := index.Load(bytes.NewBufferString("{"))
+			Expect(err).To(MatchError(ContainSubstring("could not parse index")))
+		})
+	})
+})
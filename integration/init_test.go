@@ -5,7 +5,9 @@ import (
 	"os"
 
 	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/cassette"
 	"github.com/pivotal-cf/go-pivnet/logger"
+	"github.com/pivotal-cf/go-pivnet/pivnettest"
 	"github.com/robdimsdale/sanitizer"
 
 	. "github.com/onsi/ginkgo"
@@ -16,7 +18,11 @@ import (
 
 const testProductSlug = "pivnet-resource-test"
 
-var client pivnet.Client
+var (
+	client   pivnet.Client
+	server   *pivnettest.Server
+	recorder *cassette.Recorder
+)
 
 func TestIntegration(t *testing.T) {
 	RegisterFailHandler(Fail)
@@ -27,6 +33,34 @@ var _ = BeforeSuite(func() {
 	APIToken := os.Getenv("API_TOKEN")
 	Host := os.Getenv("HOST")
 
+	cassettePath := os.Getenv("CASSETTE")
+	cassetteMode := cassette.Mode(os.Getenv("CASSETTE_MODE"))
+	if cassetteMode == "" {
+		cassetteMode = cassette.ModeReplay
+	}
+
+	if cassettePath != "" && cassetteMode == cassette.ModeReplay {
+		By("Replaying interactions from " + cassettePath)
+		if APIToken == "" {
+			APIToken = "replayed-api-token"
+		}
+		if Host == "" {
+			Host = pivnet.DefaultHost
+		}
+	}
+
+	if APIToken == "" && Host == "" {
+		By("Running against an in-memory server as HOST and API_TOKEN are not set")
+		fixture, err := pivnettest.LoadFixtureFile("fixtures/pivnet.yml")
+		Expect(err).NotTo(HaveOccurred())
+
+		server = pivnettest.NewServer()
+		Expect(server.Seed(fixture)).To(Succeed())
+
+		APIToken = "offline-api-token"
+		Host = server.URL()
+	}
+
 	if APIToken == "" {
 		Fail("API_TOKEN must be set for integration tests to run")
 	}
@@ -48,6 +82,17 @@ var _ = BeforeSuite(func() {
 		UserAgent: "go-pivnet/integration-test",
 	}
 
+	if cassettePath != "" {
+		var err error
+		recorder, err = cassette.New(cassettePath, cassette.Config{
+			Mode:  cassetteMode,
+			Token: APIToken,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		config.Transport = recorder
+	}
+
 	logger := GinkgoLogShim{}
 
 	client = pivnet.NewClient(config, logger)
@@ -57,6 +102,16 @@ var _ = BeforeSuite(func() {
 	Expect(ok).To(BeTrue())
 })
 
+var _ = AfterSuite(func() {
+	if recorder != nil {
+		Expect(recorder.Stop()).To(Succeed())
+	}
+
+	if server != nil {
+		server.Close()
+	}
+})
+
 type GinkgoLogShim struct {
 }
 
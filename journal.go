@@ -0,0 +1,100 @@
+package pivnet
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet/journal"
+	"github.com/pivotal-cf/go-pivnet/logger"
+)
+
+// journalEntry builds the journal record for a mutating request. A nil
+// entry records nothing.
+type journalEntry struct {
+	sink   journal.Sink
+	logger logger.Logger
+	start  time.Time
+	record journal.Record
+}
+
+func (c Client) startJournal(req *http.Request) *journalEntry {
+	if c.journal == nil || isRead(req) {
+		return nil
+	}
+
+	var body []byte
+	if req.GetBody != nil {
+		if r, err := req.GetBody(); err == nil {
+			body, _ = ioutil.ReadAll(r)
+			r.Close()
+		}
+	}
+
+	actor := c.journalActor
+	if actor == "" && c.token != "" {
+		actor = journal.Fingerprint(c.token)
+	}
+
+	start := time.Now()
+
+	return &journalEntry{
+		sink:   c.journal,
+		logger: c.logger,
+		start:  start,
+		record: journal.Record{
+			Time:        start.UTC(),
+			Actor:       actor,
+			Method:      req.Method,
+			URL:         req.URL.String(),
+			Endpoint:    endpointTemplate(req),
+			RequestBody: journal.Body(body),
+		},
+	}
+}
+
+func (e *journalEntry) failed(statusCode int, err error) {
+	if e == nil {
+		return
+	}
+
+	e.record.StatusCode = statusCode
+	e.record.Error = err.Error()
+	e.write()
+}
+
+// succeeded records the response body, leaving it to be read again by
+// the service.
+func (e *journalEntry) succeeded(resp *http.Response) error {
+	if e == nil {
+		return nil
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		e.failed(resp.StatusCode, err)
+		return err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+
+	e.record.StatusCode = resp.StatusCode
+	e.record.ResponseBody = journal.Body(b)
+	e.write()
+
+	return nil
+}
+
+func (e *journalEntry) write() {
+	e.record.Duration = time.Since(e.start)
+
+	err := e.sink.Record(e.record)
+	if err != nil {
+		logger.Error(e.logger, "Could not write journal record", logger.Data{
+			"method":   e.record.Method,
+			"endpoint": e.record.Endpoint,
+			"error":    err,
+		})
+	}
+}
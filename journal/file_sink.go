@@ -0,0 +1,57 @@
+package journal
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// FileSink appends each record to a file as a line of JSON.
+type FileSink struct {
+	mutex sync.Mutex
+	file  *os.File
+}
+
+// NewFileSink opens path for appending, creating it with mode 0600 if it
+// does not exist.
+func NewFileSink(path string) (*FileSink, error) {
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return nil, fmt.Errorf("could not create journal directory: %s", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("could not open journal: %s", err)
+	}
+
+	return &FileSink{file: f}, nil
+}
+
+func (s *FileSink) Record(record Record) error {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// A single write per record keeps lines whole when several processes
+	// share the file
+	_, err = s.file.Write(append(b, '\n'))
+	if err != nil {
+		return err
+	}
+
+	return s.file.Sync()
+}
+
+func (s *FileSink) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.file.Close()
+}
@@ -0,0 +1,87 @@
+package journal_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pivotal-cf/go-pivnet/journal"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FileSink", func() {
+	var (
+		dir  string
+		path string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "journal")
+		Expect(err).NotTo(HaveOccurred())
+
+		path = filepath.Join(dir, "audit", "pivnet.jsonl")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	readRecords := func() []journal.Record {
+		f, err := os.Open(path)
+		Expect(err).NotTo(HaveOccurred())
+		defer f.Close()
+
+		var records []journal.Record
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			var record journal.Record
+			Expect(json.Unmarshal(scanner.Bytes(), &record)).To(Succeed())
+			records = append(records, record)
+		}
+		Expect(scanner.Err()).NotTo(HaveOccurred())
+
+		return records
+	}
+
+	It("appends a line per record, keeping earlier records", func() {
+		sink, err := journal.NewFileSink(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sink.Record(journal.Record{Method: "POST", RequestBody: journal.Body([]byte(`{"id":1}`))})).To(Succeed())
+		Expect(sink.Close()).To(Succeed())
+
+		sink, err = journal.NewFileSink(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sink.Record(journal.Record{Method: "DELETE", Error: "not found"})).To(Succeed())
+		Expect(sink.Close()).To(Succeed())
+
+		records := readRecords()
+		Expect(records).To(HaveLen(2))
+		Expect(records[0].Method).To(Equal("POST"))
+		Expect(string(records[0].RequestBody)).To(Equal(`{"id":1}`))
+		Expect(records[1].Method).To(Equal("DELETE"))
+		Expect(records[1].Succeeded()).To(BeFalse())
+
+		info, err := os.Stat(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+	})
+})
+
+var _ = Describe("Body", func() {
+	It("keeps JSON as is", func() {
+		Expect(string(journal.Body([]byte(`{"a":1}`)))).To(Equal(`{"a":1}`))
+	})
+
+	It("quotes other bodies", func() {
+		Expect(string(journal.Body([]byte("not json")))).To(Equal(`"not json"`))
+	})
+
+	It("omits empty bodies", func() {
+		Expect(journal.Body(nil)).To(BeNil())
+	})
+})
@@ -0,0 +1,87 @@
+// Package journal records the changes a client makes to Pivnet, for audit
+// trails.
+//
+// Set a Sink as pivnet.ClientConfig.Journal to receive a Record for every
+// POST, PUT, PATCH and DELETE request, whether it succeeded or failed:
+//
+//	sink, err := journal.NewFileSink("pivnet-audit.jsonl")
+//	if err != nil {
+//		return err
+//	}
+//	defer sink.Close()
+//
+//	config.Journal = sink
+package journal
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+// Record describes a mutating API request and its outcome.
+type Record struct {
+	Time     time.Time     `json:"time"`
+	Duration time.Duration `json:"duration"`
+
+	// Actor identifies who made the request. It defaults to a
+	// fingerprint of the API token.
+	Actor string `json:"actor"`
+
+	Method string `json:"method"`
+	URL    string `json:"url"`
+
+	// Endpoint is the URL path with IDs and slugs replaced by
+	// placeholders, such as /products/:product_slug/releases/:id.
+	Endpoint string `json:"endpoint"`
+
+	RequestBody json.RawMessage `json:"request_body,omitempty"`
+
+	// StatusCode is 0 if no response was received.
+	StatusCode int `json:"status_code,omitempty"`
+
+	// ResponseBody is the resulting resource for successful requests.
+	ResponseBody json.RawMessage `json:"response_body,omitempty"`
+
+	// Error is set for failed requests.
+	Error string `json:"error,omitempty"`
+}
+
+// Succeeded reports whether the request made its change.
+func (r Record) Succeeded() bool {
+	return r.Error == ""
+}
+
+// Sink receives records. Errors are logged by the client; they do not
+// fail the request, which has already been made.
+type Sink interface {
+	Record(record Record) error
+}
+
+// SinkFunc adapts a function to a Sink.
+type SinkFunc func(record Record) error
+
+func (f SinkFunc) Record(record Record) error {
+	return f(record)
+}
+
+// Body converts a request or response body for a Record. Bodies that are
+// not JSON are kept as strings.
+func Body(b []byte) json.RawMessage {
+	if len(b) == 0 {
+		return nil
+	}
+	if json.Valid(b) {
+		return json.RawMessage(b)
+	}
+
+	quoted, _ := json.Marshal(string(b))
+	return json.RawMessage(quoted)
+}
+
+// Fingerprint identifies an API token without revealing it.
+func Fingerprint(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return "token:" + hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,147 @@
+package pivnet_test
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/journal"
+	"github.com/pivotal-cf/go-pivnet/logger/loggerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PivnetClient - journal", func() {
+	var (
+		server     *ghttp.Server
+		fakeLogger *loggerfakes.FakeLeveledLogger
+		records    []journal.Record
+		sinkErr    error
+		config     pivnet.ClientConfig
+		client     pivnet.Client
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		fakeLogger = &loggerfakes.FakeLeveledLogger{}
+		records = nil
+		sinkErr = nil
+
+		config = pivnet.ClientConfig{
+			Host:  server.URL(),
+			Token: "my-auth-token",
+			Journal: journal.SinkFunc(func(record journal.Record) error {
+				records = append(records, record)
+				return sinkErr
+			}),
+		}
+	})
+
+	JustBeforeEach(func() {
+		client = pivnet.NewClient(config, fakeLogger)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("records successful changes with the resulting resource", func() {
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("PATCH", apiPrefix+"/products/some-product/releases/1234"),
+			ghttp.RespondWith(http.StatusOK, `{"release":{"id":1234,"version":"1.2.3"}}`),
+		))
+
+		release, err := client.Releases.Update("some-product", pivnet.Release{ID: 1234, Version: "1.2.3"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(release.Version).To(Equal("1.2.3"))
+
+		Expect(records).To(HaveLen(1))
+		record := records[0]
+		Expect(record.Succeeded()).To(BeTrue())
+		Expect(record.Actor).To(Equal(journal.Fingerprint("my-auth-token")))
+		Expect(record.Actor).NotTo(ContainSubstring("my-auth-token"))
+		Expect(record.Method).To(Equal("PATCH"))
+		Expect(record.URL).To(Equal(server.URL() + apiPrefix + "/products/some-product/releases/1234"))
+		Expect(record.Endpoint).To(Equal("/products/:product_slug/releases/:id"))
+		Expect(string(record.RequestBody)).To(ContainSubstring(`"version":"1.2.3"`))
+		Expect(record.StatusCode).To(Equal(http.StatusOK))
+		Expect(string(record.ResponseBody)).To(Equal(`{"release":{"id":1234,"version":"1.2.3"}}`))
+		Expect(record.Time).NotTo(BeZero())
+	})
+
+	It("records failed changes", func() {
+		server.AppendHandlers(ghttp.RespondWith(http.StatusForbidden, `{"message":"not allowed"}`))
+
+		err := client.EULA.Accept("some-product", 1234)
+		Expect(err).To(HaveOccurred())
+
+		Expect(records).To(HaveLen(1))
+		Expect(records[0].Succeeded()).To(BeFalse())
+		Expect(records[0].Method).To(Equal("POST"))
+		Expect(records[0].StatusCode).To(Equal(http.StatusForbidden))
+		Expect(records[0].Error).To(ContainSubstring("not allowed"))
+		Expect(records[0].ResponseBody).To(BeNil())
+	})
+
+	It("records requests that received no response", func() {
+		server.Close()
+
+		err := client.EULA.Accept("some-product", 1234)
+		Expect(err).To(HaveOccurred())
+
+		Expect(records).To(HaveLen(1))
+		Expect(records[0].StatusCode).To(BeZero())
+		Expect(records[0].Error).NotTo(BeEmpty())
+	})
+
+	It("does not record reads", func() {
+		server.AppendHandlers(ghttp.RespondWith(http.StatusOK, `{"id":1234}`))
+
+		_, err := client.Releases.Get("some-product", 1234)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(records).To(BeEmpty())
+	})
+
+	It("logs records the sink could not write without failing the request", func() {
+		sinkErr = errors.New("disk full")
+		server.AppendHandlers(ghttp.RespondWith(http.StatusOK, `{}`))
+
+		err := client.EULA.Accept("some-product", 1234)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeLogger.ErrorCallCount()).To(Equal(1))
+		action, data := fakeLogger.ErrorArgsForCall(0)
+		Expect(action).To(Equal("Could not write journal record"))
+		Expect(data[0]["error"]).To(MatchError("disk full"))
+	})
+
+	Context("when the actor is configured", func() {
+		BeforeEach(func() {
+			config.JournalActor = "release-engineer@example.com"
+		})
+
+		It("records the actor", func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusOK, `{}`))
+
+			Expect(client.EULA.Accept("some-product", 1234)).To(Succeed())
+
+			Expect(records).To(HaveLen(1))
+			Expect(records[0].Actor).To(Equal("release-engineer@example.com"))
+		})
+	})
+
+	Context("when dry run is enabled", func() {
+		BeforeEach(func() {
+			config.DryRun = true
+		})
+
+		It("does not record skipped requests", func() {
+			Expect(client.EULA.Accept("some-product", 1234)).To(Succeed())
+
+			Expect(records).To(BeEmpty())
+		})
+	})
+})
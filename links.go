@@ -5,4 +5,5 @@ type Links struct {
 	Download       map[string]string `json:"download,omitempty" yaml:"download,omitempty"`
 	ProductFiles   map[string]string `json:"product_files,omitempty" yaml:"product_files,omitempty"`
 	EULAAcceptance map[string]string `json:"eula_acceptance,omitempty" yaml:"eula_acceptance,omitempty"`
+	Next           map[string]string `json:"next,omitempty" yaml:"next,omitempty"`
 }
@@ -0,0 +1,260 @@
+// Package lint checks the upgrade path and dependency specifiers of a
+// release for mistakes the API accepts.
+package lint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/logger"
+	"github.com/pivotal-cf/go-pivnet/specifier"
+)
+
+type Severity string
+
+const (
+	SeverityInfo    Severity = "info"
+	SeverityWarning Severity = "warning"
+	SeverityError   Severity = "error"
+)
+
+var severityRanks = map[Severity]int{
+	SeverityInfo:    0,
+	SeverityWarning: 1,
+	SeverityError:   2,
+}
+
+// AtLeast reports whether s is as severe as o or more.
+func (s Severity) AtLeast(o Severity) bool {
+	return severityRanks[s] >= severityRanks[o]
+}
+
+type Rule string
+
+const (
+	RuleSyntax    Rule = "syntax"
+	RuleNoMatch   Rule = "no-match"
+	RuleSelfMatch Rule = "self-match"
+	RuleOverlap   Rule = "explicit-overlap"
+)
+
+type Kind string
+
+const (
+	KindUpgradePathSpecifier Kind = "upgrade_path_specifier"
+	KindDependencySpecifier  Kind = "dependency_specifier"
+)
+
+type Finding struct {
+	Severity    Severity `json:"severity" yaml:"severity"`
+	Rule        Rule     `json:"rule" yaml:"rule"`
+	Kind        Kind     `json:"kind" yaml:"kind"`
+	SpecifierID int      `json:"specifier_id" yaml:"specifier_id"`
+	ProductSlug string   `json:"product_slug" yaml:"product_slug"`
+	Specifier   string   `json:"specifier" yaml:"specifier"`
+	Message     string   `json:"message" yaml:"message"`
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s '%s' (%s): %s", f.Severity, f.Kind, f.Specifier, f.Rule, f.Message)
+}
+
+// Failed reports whether any finding is at least as severe as threshold.
+func Failed(findings []Finding, threshold Severity) bool {
+	for _, f := range findings {
+		if f.Severity.AtLeast(threshold) {
+			return true
+		}
+	}
+	return false
+}
+
+type Linter struct {
+	client pivnet.Client
+	logger logger.Logger
+}
+
+func NewLinter(client pivnet.Client, logger logger.Logger) Linter {
+	return Linter{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Lint returns the findings for every upgrade path and dependency
+// specifier of the release, in the order the API lists them.
+func (l Linter) Lint(productSlug string, releaseID int) ([]Finding, error) {
+	release, err := l.client.Releases.Get(productSlug, releaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	l.logger.Debug("Linting release", logger.Data{"product_slug": productSlug, "version": release.Version})
+
+	releases := map[string][]pivnet.Release{}
+	productReleases := func(slug string) ([]pivnet.Release, error) {
+		if r, ok := releases[slug]; ok {
+			return r, nil
+		}
+
+		r, err := l.client.Releases.List(slug)
+		if err != nil {
+			return nil, err
+		}
+
+		releases[slug] = r
+		return r, nil
+	}
+
+	upgradeFindings, err := l.lintUpgradePathSpecifiers(productSlug, release, productReleases)
+	if err != nil {
+		return nil, err
+	}
+
+	dependencyFindings, err := l.lintDependencySpecifiers(productSlug, release, productReleases)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(upgradeFindings, dependencyFindings...), nil
+}
+
+func (l Linter) lintUpgradePathSpecifiers(
+	productSlug string,
+	release pivnet.Release,
+	productReleases func(string) ([]pivnet.Release, error),
+) ([]Finding, error) {
+	upgradePathSpecifiers, err := l.client.UpgradePathSpecifiers.List(productSlug, release.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(upgradePathSpecifiers) == 0 {
+		return nil, nil
+	}
+
+	candidates, err := productReleases(productSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	upgradePaths, err := l.client.ReleaseUpgradePaths.Get(productSlug, release.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	explicit := map[int]bool{}
+	for _, upgradePath := range upgradePaths {
+		explicit[upgradePath.Release.ID] = true
+	}
+
+	var findings []Finding
+	for _, s := range upgradePathSpecifiers {
+		finding := func(severity Severity, rule Rule, format string, args ...interface{}) Finding {
+			return Finding{
+				Severity:    severity,
+				Rule:        rule,
+				Kind:        KindUpgradePathSpecifier,
+				SpecifierID: s.ID,
+				ProductSlug: productSlug,
+				Specifier:   s.Specifier,
+				Message:     fmt.Sprintf(format, args...),
+			}
+		}
+
+		if _, err := specifier.Parse(s.Specifier); err != nil {
+			findings = append(findings, finding(SeverityError, RuleSyntax, "%s", err.Error()))
+			continue
+		}
+
+		matching, err := pivnet.ReleasesMatchingSpecifier(s.Specifier, candidates)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(matching) == 0 {
+			findings = append(findings, finding(SeverityWarning, RuleNoMatch,
+				"matches no release of '%s'", productSlug))
+			continue
+		}
+
+		var overlapping []string
+		for _, m := range matching {
+			if m.ID == release.ID {
+				findings = append(findings, finding(SeverityWarning, RuleSelfMatch,
+					"matches the release itself (%s)", release.Version))
+			}
+			if explicit[m.ID] {
+				overlapping = append(overlapping, m.Version)
+			}
+		}
+
+		if len(overlapping) > 0 {
+			findings = append(findings, finding(SeverityInfo, RuleOverlap,
+				"matches releases that are already explicit upgrade paths: %s", strings.Join(overlapping, ", ")))
+		}
+	}
+
+	return findings, nil
+}
+
+func (l Linter) lintDependencySpecifiers(
+	productSlug string,
+	release pivnet.Release,
+	productReleases func(string) ([]pivnet.Release, error),
+) ([]Finding, error) {
+	dependencySpecifiers, err := l.client.DependencySpecifiers.List(productSlug, release.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []Finding
+	for _, s := range dependencySpecifiers {
+		dependencySlug := s.Product.Slug
+
+		finding := func(severity Severity, rule Rule, format string, args ...interface{}) Finding {
+			return Finding{
+				Severity:    severity,
+				Rule:        rule,
+				Kind:        KindDependencySpecifier,
+				SpecifierID: s.ID,
+				ProductSlug: dependencySlug,
+				Specifier:   s.Specifier,
+				Message:     fmt.Sprintf(format, args...),
+			}
+		}
+
+		if _, err := specifier.Parse(s.Specifier); err != nil {
+			findings = append(findings, finding(SeverityError, RuleSyntax, "%s", err.Error()))
+			continue
+		}
+
+		candidates, err := productReleases(dependencySlug)
+		if err != nil {
+			return nil, err
+		}
+
+		matching, err := pivnet.ReleasesMatchingSpecifier(s.Specifier, candidates)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(matching) == 0 {
+			findings = append(findings, finding(SeverityError, RuleNoMatch,
+				"matches no release of '%s'", dependencySlug))
+			continue
+		}
+
+		if dependencySlug == productSlug {
+			for _, m := range matching {
+				if m.ID == release.ID {
+					findings = append(findings, finding(SeverityWarning, RuleSelfMatch,
+						"matches the release itself (%s)", release.Version))
+				}
+			}
+		}
+	}
+
+	return findings, nil
+}
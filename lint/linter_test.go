@@ -0,0 +1,184 @@
+package lint_test
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/lint"
+	"github.com/pivotal-cf/go-pivnet/logger/loggerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Linter", func() {
+	var (
+		server *ghttp.Server
+		linter lint.Linter
+
+		upgradePathSpecifiers string
+		dependencySpecifiers  string
+	)
+
+	releaseURL := func(productSlug string, suffix string) string {
+		return fmt.Sprintf("%s/products/%s/releases%s", apiPrefix, productSlug, suffix)
+	}
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+
+		fakeLogger := &loggerfakes.FakeLogger{}
+		client := pivnet.NewClient(pivnet.ClientConfig{
+			Host:      server.URL(),
+			Token:     "my-auth-token",
+			UserAgent: "pivnet-resource/0.1.0 (some-url)",
+		}, fakeLogger)
+
+		linter = lint.NewLinter(client, fakeLogger)
+
+		upgradePathSpecifiers = ""
+		dependencySpecifiers = ""
+
+		server.RouteToHandler("GET", releaseURL("a", "/3"),
+			ghttp.RespondWith(http.StatusOK, `{"id":3,"version":"1.2.0"}`))
+		server.RouteToHandler("GET", releaseURL("a", ""),
+			ghttp.RespondWith(http.StatusOK, `{"releases":[
+				{"id":1,"version":"1.0.0"},
+				{"id":2,"version":"1.1.0"},
+				{"id":3,"version":"1.2.0"}
+			]}`))
+		server.RouteToHandler("GET", releaseURL("b", ""),
+			ghttp.RespondWith(http.StatusOK, `{"releases":[{"id":10,"version":"2.0.0"}]}`))
+		server.RouteToHandler("GET", releaseURL("a", "/3/upgrade_paths"),
+			ghttp.RespondWith(http.StatusOK, `{"upgrade_paths":[{"release":{"id":1,"version":"1.0.0"}}]}`))
+		server.RouteToHandler("GET", releaseURL("a", "/3/upgrade_path_specifiers"),
+			func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{"upgrade_path_specifiers":[%s]}`, upgradePathSpecifiers)
+			})
+		server.RouteToHandler("GET", releaseURL("a", "/3/dependency_specifiers"),
+			func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{"dependency_specifiers":[%s]}`, dependencySpecifiers)
+			})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("returns no findings for valid specifiers", func() {
+		upgradePathSpecifiers = `{"id":1,"specifier":"1.1.*"}`
+		dependencySpecifiers = `{"id":2,"product":{"slug":"b"},"specifier":"~> 2.0"}`
+
+		findings, err := linter.Lint("a", 3)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(findings).To(BeEmpty())
+	})
+
+	It("flags syntax errors", func() {
+		upgradePathSpecifiers = `{"id":1,"specifier":"~> 1"}`
+		dependencySpecifiers = `{"id":2,"product":{"slug":"b"},"specifier":">= 2.*"}`
+
+		findings, err := linter.Lint("a", 3)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(findings).To(HaveLen(2))
+		Expect(findings[0].Severity).To(Equal(lint.SeverityError))
+		Expect(findings[0].Rule).To(Equal(lint.RuleSyntax))
+		Expect(findings[0].Kind).To(Equal(lint.KindUpgradePathSpecifier))
+		Expect(findings[0].SpecifierID).To(Equal(1))
+		Expect(findings[1].Rule).To(Equal(lint.RuleSyntax))
+		Expect(findings[1].Kind).To(Equal(lint.KindDependencySpecifier))
+		Expect(findings[1].ProductSlug).To(Equal("b"))
+	})
+
+	It("flags malformed numeric versions as syntax errors", func() {
+		upgradePathSpecifiers = `{"id":1,"specifier":"1.2.x"},{"id":4,"specifier":"1..2"}`
+		dependencySpecifiers = `{"id":2,"product":{"slug":"b"},"specifier":"2.0.x"}`
+
+		findings, err := linter.Lint("a", 3)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(findings).To(HaveLen(3))
+		for _, finding := range findings {
+			Expect(finding.Severity).To(Equal(lint.SeverityError))
+			Expect(finding.Rule).To(Equal(lint.RuleSyntax))
+		}
+		Expect(findings[0].Message).To(ContainSubstring("invalid version: '1.2.x'"))
+		Expect(findings[1].Message).To(ContainSubstring("invalid version: '1..2'"))
+		Expect(findings[2].Message).To(ContainSubstring("invalid version: '2.0.x'"))
+	})
+
+	It("flags specifiers matching no releases", func() {
+		upgradePathSpecifiers = `{"id":1,"specifier":"0.9.*"}`
+		dependencySpecifiers = `{"id":2,"product":{"slug":"b"},"specifier":"~> 3.0"}`
+
+		findings, err := linter.Lint("a", 3)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(findings).To(HaveLen(2))
+		Expect(findings[0].Severity).To(Equal(lint.SeverityWarning))
+		Expect(findings[0].Rule).To(Equal(lint.RuleNoMatch))
+		Expect(findings[0].Message).To(Equal("matches no release of 'a'"))
+		Expect(findings[1].Severity).To(Equal(lint.SeverityError))
+		Expect(findings[1].Rule).To(Equal(lint.RuleNoMatch))
+		Expect(findings[1].Message).To(Equal("matches no release of 'b'"))
+	})
+
+	It("flags specifiers matching the release itself", func() {
+		upgradePathSpecifiers = `{"id":1,"specifier":"~> 1.1"}`
+		dependencySpecifiers = `{"id":2,"product":{"slug":"a"},"specifier":"1.2.0"}`
+
+		findings, err := linter.Lint("a", 3)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(findings).To(HaveLen(2))
+		Expect(findings[0].Rule).To(Equal(lint.RuleSelfMatch))
+		Expect(findings[0].Kind).To(Equal(lint.KindUpgradePathSpecifier))
+		Expect(findings[0].Message).To(Equal("matches the release itself (1.2.0)"))
+		Expect(findings[1].Rule).To(Equal(lint.RuleSelfMatch))
+		Expect(findings[1].Kind).To(Equal(lint.KindDependencySpecifier))
+	})
+
+	It("flags overlap with explicit upgrade paths", func() {
+		upgradePathSpecifiers = `{"id":1,"specifier":"< 1.2"}`
+
+		findings, err := linter.Lint("a", 3)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(findings).To(HaveLen(1))
+		Expect(findings[0].Severity).To(Equal(lint.SeverityInfo))
+		Expect(findings[0].Rule).To(Equal(lint.RuleOverlap))
+		Expect(findings[0].String()).To(Equal(
+			"info: upgrade_path_specifier '< 1.2' (explicit-overlap): matches releases that are already explicit upgrade paths: 1.0.0",
+		))
+	})
+
+	Context("when fetching the release fails", func() {
+		BeforeEach(func() {
+			server.RouteToHandler("GET", releaseURL("a", "/3"),
+				ghttp.RespondWith(http.StatusTeapot, `{"message":"foo message"}`))
+		})
+
+		It("returns the error", func() {
+			_, err := linter.Lint("a", 3)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("foo message"))
+		})
+	})
+
+	Describe("Failed", func() {
+		findings := []lint.Finding{
+			{Severity: lint.SeverityInfo},
+			{Severity: lint.SeverityWarning},
+		}
+
+		It("reports whether any finding reaches the threshold", func() {
+			Expect(lint.Failed(findings, lint.SeverityWarning)).To(BeTrue())
+			Expect(lint.Failed(findings, lint.SeverityError)).To(BeFalse())
+			Expect(lint.Failed(nil, lint.SeverityInfo)).To(BeFalse())
+		})
+	})
+})
@@ -8,3 +8,32 @@ type Logger interface {
 	Debug(action string, data ...Data)
 	Info(action string, data ...Data)
 }
+
+//go:generate counterfeiter . LeveledLogger
+
+// LeveledLogger is a Logger that also logs warnings and errors. It is
+// separate from Logger so existing implementations keep compiling; use
+// Warn and Error to log at these levels with any Logger.
+type LeveledLogger interface {
+	Logger
+	Warn(action string, data ...Data)
+	Error(action string, data ...Data)
+}
+
+// Warn logs a warning, or logs at info level if l is not a LeveledLogger.
+func Warn(l Logger, action string, data ...Data) {
+	if leveled, ok := l.(LeveledLogger); ok {
+		leveled.Warn(action, data...)
+		return
+	}
+	l.Info(action, data...)
+}
+
+// Error logs an error, or logs at info level if l is not a LeveledLogger.
+func Error(l Logger, action string, data ...Data) {
+	if leveled, ok := l.(LeveledLogger); ok {
+		leveled.Error(action, data...)
+		return
+	}
+	l.Info(action, data...)
+}
@@ -0,0 +1,43 @@
+package logger_test
+
+import (
+	"github.com/pivotal-cf/go-pivnet/logger"
+	"github.com/pivotal-cf/go-pivnet/logger/loggerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Logger", func() {
+	data := logger.Data{"product_slug": "some-product"}
+
+	It("logs warnings and errors with a LeveledLogger", func() {
+		l := &loggerfakes.FakeLeveledLogger{}
+
+		logger.Warn(l, "some warning", data)
+		logger.Error(l, "some error", data)
+
+		Expect(l.WarnCallCount()).To(Equal(1))
+		action, warnData := l.WarnArgsForCall(0)
+		Expect(action).To(Equal("some warning"))
+		Expect(warnData).To(Equal([]logger.Data{data}))
+
+		Expect(l.ErrorCallCount()).To(Equal(1))
+		action, _ = l.ErrorArgsForCall(0)
+		Expect(action).To(Equal("some error"))
+
+		Expect(l.InfoCallCount()).To(Equal(0))
+	})
+
+	It("falls back to info for other loggers", func() {
+		l := &loggerfakes.FakeLogger{}
+
+		logger.Warn(l, "some warning", data)
+		logger.Error(l, "some error", data)
+
+		Expect(l.InfoCallCount()).To(Equal(2))
+		action, infoData := l.InfoArgsForCall(0)
+		Expect(action).To(Equal("some warning"))
+		Expect(infoData).To(Equal([]logger.Data{data}))
+	})
+})
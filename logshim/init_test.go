@@ -0,0 +1,13 @@
+package logshim_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestLogshim(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Logshim Suite")
+}
@@ -0,0 +1,39 @@
+// Package logrus provides a logger.LeveledLogger logging to a logrus
+// FieldLogger.
+package logrus
+
+import (
+	"github.com/pivotal-cf/go-pivnet/logger"
+	"github.com/pivotal-cf/go-pivnet/logshim"
+	"github.com/sirupsen/logrus"
+)
+
+// Shim logs to a logrus FieldLogger, with each key of logger.Data as a
+// field.
+type Shim struct {
+	logger logrus.FieldLogger
+}
+
+func NewShim(l logrus.FieldLogger) *Shim {
+	return &Shim{logger: l}
+}
+
+func (l Shim) Debug(action string, data ...logger.Data) {
+	l.withFields(data).Debug(action)
+}
+
+func (l Shim) Info(action string, data ...logger.Data) {
+	l.withFields(data).Info(action)
+}
+
+func (l Shim) Warn(action string, data ...logger.Data) {
+	l.withFields(data).Warn(action)
+}
+
+func (l Shim) Error(action string, data ...logger.Data) {
+	l.withFields(data).Error(action)
+}
+
+func (l Shim) withFields(data []logger.Data) logrus.FieldLogger {
+	return l.logger.WithFields(logrus.Fields(logshim.Fields(data...)))
+}
@@ -0,0 +1,62 @@
+package logrus_test
+
+import (
+	"github.com/pivotal-cf/go-pivnet/logger"
+	pivnetlogrus "github.com/pivotal-cf/go-pivnet/logshim/logrus"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Shim", func() {
+	var (
+		hook *test.Hook
+		shim *pivnetlogrus.Shim
+	)
+
+	BeforeEach(func() {
+		var l *logrus.Logger
+		l, hook = test.NewNullLogger()
+
+		shim = pivnetlogrus.NewShim(l)
+	})
+
+	It("is a logger.LeveledLogger", func() {
+		var _ logger.LeveledLogger = shim
+	})
+
+	It("logs data as fields at each level", func() {
+		shim.Debug("some debug")
+		shim.Info("some info", logger.Data{"product_slug": "some-product"}, logger.Data{"release_id": 3})
+		shim.Warn("some warning", logger.Data{"status code": 503})
+		shim.Error("some error")
+
+		entries := hook.AllEntries()
+		Expect(entries).To(HaveLen(3))
+
+		Expect(entries[0].Level).To(Equal(logrus.InfoLevel))
+		Expect(entries[0].Message).To(Equal("some info"))
+		Expect(entries[0].Data).To(Equal(logrus.Fields{
+			"product_slug": "some-product",
+			"release_id":   3,
+		}))
+
+		Expect(entries[1].Level).To(Equal(logrus.WarnLevel))
+		Expect(entries[1].Data).To(Equal(logrus.Fields{"status code": 503}))
+
+		Expect(entries[2].Level).To(Equal(logrus.ErrorLevel))
+		Expect(entries[2].Message).To(Equal("some error"))
+	})
+
+	It("logs debug entries when the logger is verbose", func() {
+		l, hook := test.NewNullLogger()
+		l.SetLevel(logrus.DebugLevel)
+
+		pivnetlogrus.NewShim(l.WithField("component", "pivnet")).Debug("some debug", logger.Data{"url": "/products"})
+
+		Expect(hook.LastEntry().Level).To(Equal(logrus.DebugLevel))
+		Expect(hook.LastEntry().Data).To(Equal(logrus.Fields{"component": "pivnet", "url": "/products"}))
+	})
+})
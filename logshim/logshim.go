@@ -1,8 +1,10 @@
 package logshim
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
 
 	"github.com/pivotal-cf/go-pivnet/logger"
 )
@@ -11,6 +13,7 @@ type LogShim struct {
 	infoLogger  *log.Logger
 	debugLogger *log.Logger
 	verbose     bool
+	json        bool
 }
 
 func NewLogShim(
@@ -25,14 +28,49 @@ func NewLogShim(
 	}
 }
 
+// NewJSONLogShim returns a LogShim writing each entry as a JSON object
+// with level, msg and the fields of its data.
+func NewJSONLogShim(
+	infoLogger *log.Logger,
+	debugLogger *log.Logger,
+	verbose bool,
+) *LogShim {
+	l := NewLogShim(infoLogger, debugLogger, verbose)
+	l.json = true
+	return l
+}
+
 func (l LogShim) Debug(action string, data ...logger.Data) {
 	if l.verbose {
-		l.debugLogger.Println(fmt.Sprintf("%s%s", action, appendString(data...)))
+		l.debugLogger.Println(l.format("debug", action, data))
 	}
 }
 
 func (l LogShim) Info(action string, data ...logger.Data) {
-	l.infoLogger.Println(fmt.Sprintf("%s%s", action, appendString(data...)))
+	l.infoLogger.Println(l.format("info", action, data))
+}
+
+func (l LogShim) Warn(action string, data ...logger.Data) {
+	l.infoLogger.Println(l.format("warn", action, data))
+}
+
+func (l LogShim) Error(action string, data ...logger.Data) {
+	l.infoLogger.Println(l.format("error", action, data))
+}
+
+func (l LogShim) format(level string, action string, data []logger.Data) string {
+	if l.json {
+		return formatJSON(level, action, data)
+	}
+
+	switch level {
+	case "warn":
+		action = "WARNING: " + action
+	case "error":
+		action = "ERROR: " + action
+	}
+
+	return fmt.Sprintf("%s%s", action, appendString(data...))
 }
 
 func appendString(data ...logger.Data) string {
@@ -41,3 +79,61 @@ func appendString(data ...logger.Data) string {
 	}
 	return ""
 }
+
+func formatJSON(level string, action string, data []logger.Data) string {
+	entry := map[string]interface{}{}
+	for k, v := range Fields(data...) {
+		entry[k] = jsonValue(v)
+	}
+	entry["level"] = level
+	entry["msg"] = action
+
+	b, err := json.Marshal(entry)
+	if err != nil {
+		b, _ = json.Marshal(map[string]interface{}{
+			"level": level,
+			"msg":   action,
+			"error": fmt.Sprintf("could not encode log data: %s", err),
+		})
+	}
+
+	return string(b)
+}
+
+// jsonValue converts values that do not encode usefully as JSON, such as
+// errors, to strings.
+func jsonValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Marshaler:
+		return v
+	case error:
+		return v.Error()
+	case fmt.Stringer:
+		return v.String()
+	}
+
+	if _, err := json.Marshal(v); err != nil {
+		return fmt.Sprintf("%+v", v)
+	}
+	return v
+}
+
+// Fields merges data into a single set of fields. Later keys win.
+func Fields(data ...logger.Data) logger.Data {
+	merged := logger.Data{}
+	for _, d := range data {
+		for k, v := range d {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+func sortedKeys(d logger.Data) []string {
+	var keys []string
+	for k := range d {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
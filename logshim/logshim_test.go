@@ -0,0 +1,103 @@
+package logshim_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log"
+	"log/slog"
+
+	"github.com/pivotal-cf/go-pivnet/logger"
+	"github.com/pivotal-cf/go-pivnet/logshim"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogShim", func() {
+	var (
+		infoOutput  *bytes.Buffer
+		debugOutput *bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		infoOutput = &bytes.Buffer{}
+		debugOutput = &bytes.Buffer{}
+	})
+
+	It("logs text with the level of warnings and errors", func() {
+		l := logshim.NewLogShim(log.New(infoOutput, "", 0), log.New(debugOutput, "", 0), false)
+
+		l.Info("some info", logger.Data{"status": 200})
+		l.Warn("some warning")
+		l.Error("some error")
+		l.Debug("some debug")
+
+		Expect(infoOutput.String()).To(Equal(
+			"some info - [map[status:200]]\nWARNING: some warning\nERROR: some error\n"))
+		Expect(debugOutput.String()).To(BeEmpty())
+	})
+
+	Describe("JSON", func() {
+		entries := func(output *bytes.Buffer) []map[string]interface{} {
+			var entries []map[string]interface{}
+			decoder := json.NewDecoder(output)
+			for decoder.More() {
+				var entry map[string]interface{}
+				Expect(decoder.Decode(&entry)).To(Succeed())
+				entries = append(entries, entry)
+			}
+			return entries
+		}
+
+		It("logs each entry as a JSON object with its data as fields", func() {
+			l := logshim.NewJSONLogShim(log.New(infoOutput, "", 0), log.New(debugOutput, "", 0), true)
+
+			l.Info("some info", logger.Data{"product_slug": "some-product"}, logger.Data{"release_id": 3})
+			l.Error("some error", logger.Data{"error": errors.New("some-error")})
+			l.Debug("some debug", logger.Data{"status code": 404})
+
+			Expect(entries(infoOutput)).To(Equal([]map[string]interface{}{
+				{"level": "info", "msg": "some info", "product_slug": "some-product", "release_id": float64(3)},
+				{"level": "error", "msg": "some error", "error": "some-error"},
+			}))
+			Expect(entries(debugOutput)).To(Equal([]map[string]interface{}{
+				{"level": "debug", "msg": "some debug", "status code": float64(404)},
+			}))
+		})
+
+		It("logs values that cannot be encoded as strings", func() {
+			l := logshim.NewJSONLogShim(log.New(infoOutput, "", 0), log.New(debugOutput, "", 0), false)
+
+			l.Info("some info", logger.Data{"func": func() {}, "channel": make(chan int)})
+
+			entry := entries(infoOutput)[0]
+			Expect(entry["func"]).To(BeAssignableToTypeOf(""))
+			Expect(entry["channel"]).To(BeAssignableToTypeOf(""))
+		})
+	})
+})
+
+var _ = Describe("SlogShim", func() {
+	It("logs data as attributes at each level", func() {
+		output := &bytes.Buffer{}
+		handler := slog.NewJSONHandler(output, &slog.HandlerOptions{
+			Level: slog.LevelInfo,
+			ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+				if a.Key == slog.TimeKey {
+					return slog.Attr{}
+				}
+				return a
+			},
+		})
+		l := logshim.NewSlogShim(slog.New(handler))
+
+		l.Debug("some debug")
+		l.Info("some info", logger.Data{"product_slug": "some-product", "release_id": 3})
+		l.Warn("some warning", logger.Data{"status code": 503})
+
+		Expect(output.String()).To(Equal(
+			`{"level":"INFO","msg":"some info","product_slug":"some-product","release_id":3}` + "\n" +
+				`{"level":"WARN","msg":"some warning","status code":503}` + "\n"))
+	})
+})
@@ -0,0 +1,53 @@
+//go:build go1.21
+// +build go1.21
+
+package logshim
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/pivotal-cf/go-pivnet/logger"
+)
+
+// SlogShim logs to a log/slog Logger, with each key of logger.Data as
+// an attribute.
+type SlogShim struct {
+	logger *slog.Logger
+}
+
+func NewSlogShim(l *slog.Logger) *SlogShim {
+	return &SlogShim{logger: l}
+}
+
+func (l SlogShim) Debug(action string, data ...logger.Data) {
+	l.log(slog.LevelDebug, action, data)
+}
+
+func (l SlogShim) Info(action string, data ...logger.Data) {
+	l.log(slog.LevelInfo, action, data)
+}
+
+func (l SlogShim) Warn(action string, data ...logger.Data) {
+	l.log(slog.LevelWarn, action, data)
+}
+
+func (l SlogShim) Error(action string, data ...logger.Data) {
+	l.log(slog.LevelError, action, data)
+}
+
+func (l SlogShim) log(level slog.Level, action string, data []logger.Data) {
+	ctx := context.Background()
+	if !l.logger.Enabled(ctx, level) {
+		return
+	}
+
+	f := Fields(data...)
+
+	var attrs []slog.Attr
+	for _, k := range sortedKeys(f) {
+		attrs = append(attrs, slog.Any(k, f[k]))
+	}
+
+	l.logger.LogAttrs(ctx, level, action, attrs...)
+}
@@ -0,0 +1,51 @@
+// Package zap provides a logger.LeveledLogger logging to a zap Logger.
+package zap
+
+import (
+	"sort"
+
+	"github.com/pivotal-cf/go-pivnet/logger"
+	"github.com/pivotal-cf/go-pivnet/logshim"
+	"go.uber.org/zap"
+)
+
+// Shim logs to a zap Logger, with each key of logger.Data as a field.
+type Shim struct {
+	logger *zap.Logger
+}
+
+func NewShim(l *zap.Logger) *Shim {
+	return &Shim{logger: l}
+}
+
+func (l Shim) Debug(action string, data ...logger.Data) {
+	l.logger.Debug(action, zapFields(data)...)
+}
+
+func (l Shim) Info(action string, data ...logger.Data) {
+	l.logger.Info(action, zapFields(data)...)
+}
+
+func (l Shim) Warn(action string, data ...logger.Data) {
+	l.logger.Warn(action, zapFields(data)...)
+}
+
+func (l Shim) Error(action string, data ...logger.Data) {
+	l.logger.Error(action, zapFields(data)...)
+}
+
+func zapFields(data []logger.Data) []zap.Field {
+	f := logshim.Fields(data...)
+
+	var keys []string
+	for k := range f {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var zapFields []zap.Field
+	for _, k := range keys {
+		zapFields = append(zapFields, zap.Any(k, f[k]))
+	}
+	return zapFields
+}
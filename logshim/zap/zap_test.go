@@ -0,0 +1,63 @@
+package zap_test
+
+import (
+	"github.com/pivotal-cf/go-pivnet/logger"
+	pivnetzap "github.com/pivotal-cf/go-pivnet/logshim/zap"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Shim", func() {
+	var (
+		logs *observer.ObservedLogs
+		shim *pivnetzap.Shim
+	)
+
+	BeforeEach(func() {
+		var core zapcore.Core
+		core, logs = observer.New(zapcore.InfoLevel)
+
+		shim = pivnetzap.NewShim(zap.New(core))
+	})
+
+	It("is a logger.LeveledLogger", func() {
+		var _ logger.LeveledLogger = shim
+	})
+
+	It("logs data as fields at each level", func() {
+		shim.Debug("some debug")
+		shim.Info("some info", logger.Data{"product_slug": "some-product"}, logger.Data{"release_id": 3})
+		shim.Warn("some warning", logger.Data{"status code": 503})
+		shim.Error("some error")
+
+		entries := logs.AllUntimed()
+		Expect(entries).To(HaveLen(3))
+
+		Expect(entries[0].Level).To(Equal(zapcore.InfoLevel))
+		Expect(entries[0].Message).To(Equal("some info"))
+		Expect(entries[0].ContextMap()).To(Equal(map[string]interface{}{
+			"product_slug": "some-product",
+			"release_id":   int64(3),
+		}))
+
+		Expect(entries[1].Level).To(Equal(zapcore.WarnLevel))
+		Expect(entries[1].ContextMap()).To(Equal(map[string]interface{}{"status code": int64(503)}))
+
+		Expect(entries[2].Level).To(Equal(zapcore.ErrorLevel))
+		Expect(entries[2].Message).To(Equal("some error"))
+	})
+
+	It("orders fields by key", func() {
+		shim.Info("some info", logger.Data{"b": 2, "a": 1, "c": 3})
+
+		var keys []string
+		for _, field := range logs.All()[0].Context {
+			keys = append(keys, field.Key)
+		}
+		Expect(keys).To(Equal([]string{"a", "b", "c"}))
+	})
+})
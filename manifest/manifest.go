@@ -0,0 +1,193 @@
+// Package manifest describes a release declaratively and reconciles it
+// against Pivotal Network.
+package manifest
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/pivotal-cf/go-pivnet"
+	"gopkg.in/yaml.v2"
+)
+
+type Manifest struct {
+	ProductSlug           string                `yaml:"product_slug" json:"product_slug"`
+	Release               Release               `yaml:"release" json:"release"`
+	ProductFiles          []ProductFile         `yaml:"product_files,omitempty" json:"product_files,omitempty"`
+	FileGroups            []FileGroup           `yaml:"file_groups,omitempty" json:"file_groups,omitempty"`
+	UserGroups            []UserGroup           `yaml:"user_groups,omitempty" json:"user_groups,omitempty"`
+	DependencySpecifiers  []DependencySpecifier `yaml:"dependency_specifiers,omitempty" json:"dependency_specifiers,omitempty"`
+	UpgradePathSpecifiers []string              `yaml:"upgrade_path_specifiers,omitempty" json:"upgrade_path_specifiers,omitempty"`
+}
+
+type Release struct {
+	Version               string `yaml:"version" json:"version"`
+	ReleaseType           string `yaml:"release_type,omitempty" json:"release_type,omitempty"`
+	EULASlug              string `yaml:"eula_slug,omitempty" json:"eula_slug,omitempty"`
+	ReleaseDate           string `yaml:"release_date,omitempty" json:"release_date,omitempty"`
+	Description           string `yaml:"description,omitempty" json:"description,omitempty"`
+	ReleaseNotesURL       string `yaml:"release_notes_url,omitempty" json:"release_notes_url,omitempty"`
+	Controlled            *bool  `yaml:"controlled,omitempty" json:"controlled,omitempty"`
+	ECCN                  string `yaml:"eccn,omitempty" json:"eccn,omitempty"`
+	LicenseException      string `yaml:"license_exception,omitempty" json:"license_exception,omitempty"`
+	EndOfSupportDate      string `yaml:"end_of_support_date,omitempty" json:"end_of_support_date,omitempty"`
+	EndOfGuidanceDate     string `yaml:"end_of_guidance_date,omitempty" json:"end_of_guidance_date,omitempty"`
+	EndOfAvailabilityDate string `yaml:"end_of_availability_date,omitempty" json:"end_of_availability_date,omitempty"`
+}
+
+// ProductFile is keyed by its AWS object key.
+// Files listed by a file group are attached to the release through that
+// group rather than directly.
+type ProductFile struct {
+	AWSObjectKey       string   `yaml:"aws_object_key" json:"aws_object_key"`
+	Name               string   `yaml:"name,omitempty" json:"name,omitempty"`
+	Description        string   `yaml:"description,omitempty" json:"description,omitempty"`
+	DocsURL            string   `yaml:"docs_url,omitempty" json:"docs_url,omitempty"`
+	FileType           string   `yaml:"file_type,omitempty" json:"file_type,omitempty"`
+	FileVersion        string   `yaml:"file_version,omitempty" json:"file_version,omitempty"`
+	SHA256             string   `yaml:"sha256,omitempty" json:"sha256,omitempty"`
+	MD5                string   `yaml:"md5,omitempty" json:"md5,omitempty"`
+	ReleasedAt         string   `yaml:"released_at,omitempty" json:"released_at,omitempty"`
+	IncludedFiles      []string `yaml:"included_files,omitempty" json:"included_files,omitempty"`
+	Platforms          []string `yaml:"platforms,omitempty" json:"platforms,omitempty"`
+	SystemRequirements []string `yaml:"system_requirements,omitempty" json:"system_requirements,omitempty"`
+}
+
+// FileGroup lists its product files by AWS object key.
+type FileGroup struct {
+	Name         string   `yaml:"name" json:"name"`
+	ProductFiles []string `yaml:"product_files,omitempty" json:"product_files,omitempty"`
+}
+
+type UserGroup struct {
+	Name        string `yaml:"name" json:"name"`
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+}
+
+type DependencySpecifier struct {
+	ProductSlug string `yaml:"product_slug" json:"product_slug"`
+	Specifier   string `yaml:"specifier" json:"specifier"`
+}
+
+// Load reads a YAML or JSON manifest.
+func Load(r io.Reader) (Manifest, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	var m Manifest
+	err = yaml.Unmarshal(b, &m)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	return m, nil
+}
+
+func LoadFile(path string) (Manifest, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Manifest{}, err
+	}
+	defer f.Close()
+
+	return Load(f)
+}
+
+func (m Manifest) Validate() error {
+	if m.ProductSlug == "" {
+		return fmt.Errorf("product_slug must not be empty")
+	}
+
+	if m.Release.Version == "" {
+		return fmt.Errorf("release.version must not be empty")
+	}
+
+	productFiles := map[string]bool{}
+	for _, productFile := range m.ProductFiles {
+		if productFile.AWSObjectKey == "" {
+			return fmt.Errorf("product_files: aws_object_key must not be empty")
+		}
+		if productFiles[productFile.AWSObjectKey] {
+			return fmt.Errorf("product_files: duplicate aws_object_key '%s'", productFile.AWSObjectKey)
+		}
+		productFiles[productFile.AWSObjectKey] = true
+	}
+
+	fileGroups := map[string]bool{}
+	grouped := map[string]string{}
+	for _, fileGroup := range m.FileGroups {
+		if fileGroup.Name == "" {
+			return fmt.Errorf("file_groups: name must not be empty")
+		}
+		if fileGroups[fileGroup.Name] {
+			return fmt.Errorf("file_groups: duplicate name '%s'", fileGroup.Name)
+		}
+		fileGroups[fileGroup.Name] = true
+
+		for _, key := range fileGroup.ProductFiles {
+			if !productFiles[key] {
+				return fmt.Errorf("file_groups: '%s' references unknown product file '%s'", fileGroup.Name, key)
+			}
+			if other, ok := grouped[key]; ok {
+				return fmt.Errorf("file_groups: product file '%s' is in both '%s' and '%s'", key, other, fileGroup.Name)
+			}
+			grouped[key] = fileGroup.Name
+		}
+	}
+
+	for _, userGroup := range m.UserGroups {
+		if userGroup.Name == "" {
+			return fmt.Errorf("user_groups: name must not be empty")
+		}
+	}
+
+	for _, dependencySpecifier := range m.DependencySpecifiers {
+		if dependencySpecifier.ProductSlug == "" || dependencySpecifier.Specifier == "" {
+			return fmt.Errorf("dependency_specifiers: product_slug and specifier must not be empty")
+		}
+	}
+
+	return nil
+}
+
+func (r Release) ensureConfig(productSlug string) pivnet.EnsureReleaseConfig {
+	return pivnet.EnsureReleaseConfig{
+		CreateReleaseConfig: pivnet.CreateReleaseConfig{
+			ProductSlug:           productSlug,
+			Version:               r.Version,
+			ReleaseType:           r.ReleaseType,
+			ReleaseDate:           r.ReleaseDate,
+			EULASlug:              r.EULASlug,
+			Description:           r.Description,
+			ReleaseNotesURL:       r.ReleaseNotesURL,
+			ECCN:                  r.ECCN,
+			LicenseException:      r.LicenseException,
+			EndOfSupportDate:      r.EndOfSupportDate,
+			EndOfGuidanceDate:     r.EndOfGuidanceDate,
+			EndOfAvailabilityDate: r.EndOfAvailabilityDate,
+		},
+		Controlled: r.Controlled,
+	}
+}
+
+func (f ProductFile) createConfig(productSlug string) pivnet.CreateProductFileConfig {
+	return pivnet.CreateProductFileConfig{
+		ProductSlug:        productSlug,
+		AWSObjectKey:       f.AWSObjectKey,
+		Description:        f.Description,
+		DocsURL:            f.DocsURL,
+		FileType:           f.FileType,
+		FileVersion:        f.FileVersion,
+		IncludedFiles:      f.IncludedFiles,
+		SHA256:             f.SHA256,
+		MD5:                f.MD5,
+		Name:               f.Name,
+		Platforms:          f.Platforms,
+		ReleasedAt:         f.ReleasedAt,
+		SystemRequirements: f.SystemRequirements,
+	}
+}
@@ -0,0 +1,103 @@
+package manifest_test
+
+import (
+	"strings"
+
+	"github.com/pivotal-cf/go-pivnet/manifest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manifest", func() {
+	Describe("LoadFile", func() {
+		It("loads a YAML manifest", func() {
+			m, err := manifest.LoadFile("fixtures/release.yml")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(m.ProductSlug).To(Equal(productSlug))
+			Expect(m.Release.Version).To(Equal("1.2.3"))
+			Expect(m.Release.EULASlug).To(Equal("some-eula"))
+			Expect(m.ProductFiles).To(HaveLen(2))
+			Expect(m.ProductFiles[0].Platforms).To(Equal([]string{"Linux"}))
+			Expect(m.FileGroups).To(Equal([]manifest.FileGroup{
+				{Name: "Documentation", ProductFiles: []string{"product-files/some-product/docs.pdf"}},
+			}))
+			Expect(m.UserGroups).To(Equal([]manifest.UserGroup{{Name: "Early Access"}}))
+			Expect(m.DependencySpecifiers).To(Equal([]manifest.DependencySpecifier{
+				{ProductSlug: "other-product", Specifier: "2.0.*"},
+			}))
+			Expect(m.UpgradePathSpecifiers).To(Equal([]string{"1.1.*"}))
+
+			Expect(m.Validate()).To(Succeed())
+		})
+
+		It("loads a JSON manifest", func() {
+			m, err := manifest.LoadFile("fixtures/release.json")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(m.Release.ReleaseType).To(Equal("Minor Release"))
+			Expect(m.UpgradePathSpecifiers).To(Equal([]string{"1.1.*"}))
+		})
+
+		Context("when the file does not exist", func() {
+			It("returns an error", func() {
+				_, err := manifest.LoadFile("fixtures/missing.yml")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Load", func() {
+		Context("when the manifest is malformed", func() {
+			It("returns an error", func() {
+				_, err := manifest.Load(strings.NewReader("release: [unclosed"))
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Validate", func() {
+		var (
+			m manifest.Manifest
+		)
+
+		BeforeEach(func() {
+			m = manifest.Manifest{
+				ProductSlug: productSlug,
+				Release:     manifest.Release{Version: "1.2.3"},
+				ProductFiles: []manifest.ProductFile{
+					{AWSObjectKey: "a"},
+					{AWSObjectKey: "b"},
+				},
+				FileGroups: []manifest.FileGroup{
+					{Name: "group", ProductFiles: []string{"a"}},
+				},
+			}
+		})
+
+		It("accepts a valid manifest", func() {
+			Expect(m.Validate()).To(Succeed())
+		})
+
+		It("requires a version", func() {
+			m.Release.Version = ""
+			Expect(m.Validate()).To(MatchError("release.version must not be empty"))
+		})
+
+		It("rejects duplicate product files", func() {
+			m.ProductFiles = append(m.ProductFiles, manifest.ProductFile{AWSObjectKey: "a"})
+			Expect(m.Validate()).To(MatchError("product_files: duplicate aws_object_key 'a'"))
+		})
+
+		It("rejects file groups referencing unknown product files", func() {
+			m.FileGroups[0].ProductFiles = []string{"c"}
+			Expect(m.Validate()).To(MatchError("file_groups: 'group' references unknown product file 'c'"))
+		})
+
+		It("rejects product files in more than one file group", func() {
+			m.FileGroups = append(m.FileGroups, manifest.FileGroup{Name: "other", ProductFiles: []string{"a"}})
+			Expect(m.Validate()).To(MatchError("file_groups: product file 'a' is in both 'group' and 'other'"))
+		})
+	})
+})
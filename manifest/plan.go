@@ -0,0 +1,617 @@
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/logger"
+)
+
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionRemove Action = "remove"
+)
+
+const (
+	ResourceRelease              = "release"
+	ResourceProductFile          = "product_file"
+	ResourceFileGroup            = "file_group"
+	ResourceFileGroupProductFile = "file_group_product_file"
+	ResourceUserGroup            = "user_group"
+	ResourceReleaseProductFile   = "release_product_file"
+	ResourceReleaseFileGroup     = "release_file_group"
+	ResourceReleaseUserGroup     = "release_user_group"
+	ResourceDependencySpecifier  = "dependency_specifier"
+	ResourceUpgradePathSpecifier = "upgrade_path_specifier"
+)
+
+// Change is a single step needed to bring Pivnet in line with a manifest.
+// Removing a release_product_file, release_file_group or
+// release_user_group only detaches it from the release.
+type Change struct {
+	Action   Action   `yaml:"action" json:"action"`
+	Resource string   `yaml:"resource" json:"resource"`
+	Name     string   `yaml:"name" json:"name"`
+	Fields   []string `yaml:"fields,omitempty" json:"fields,omitempty"`
+
+	apply func(s *state) error
+}
+
+func (c Change) String() string {
+	s := fmt.Sprintf("%s %s '%s'", c.Action, c.Resource, c.Name)
+	if len(c.Fields) > 0 {
+		s = fmt.Sprintf("%s (%s)", s, strings.Join(c.Fields, ", "))
+	}
+	return s
+}
+
+var errPlanNotProduced = errors.New("plan was not produced by Reconciler.Plan")
+
+type Plan struct {
+	ProductSlug string   `yaml:"product_slug" json:"product_slug"`
+	Version     string   `yaml:"version" json:"version"`
+	Changes     []Change `yaml:"changes" json:"changes"`
+
+	state *state
+}
+
+func (p Plan) HasChanges() bool {
+	return len(p.Changes) > 0
+}
+
+func (p Plan) String() string {
+	lines := make([]string, len(p.Changes))
+	for i, c := range p.Changes {
+		lines[i] = c.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// state holds the IDs of resources referenced by the plan. IDs of
+// resources the plan creates are filled in as it is applied.
+type state struct {
+	releaseID      int
+	productFileIDs map[string]int
+	fileGroupIDs   map[string]int
+	userGroupIDs   map[string]int
+}
+
+type Reconciler struct {
+	client pivnet.Client
+	logger logger.Logger
+}
+
+func NewReconciler(client pivnet.Client, logger logger.Logger) Reconciler {
+	return Reconciler{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Plan compares m with the current state of Pivnet and returns the
+// changes Apply would make. It does not modify anything.
+func (r Reconciler) Plan(m Manifest) (Plan, error) {
+	err := m.Validate()
+	if err != nil {
+		return Plan{}, err
+	}
+
+	p := planner{
+		client: r.client,
+		m:      m,
+		plan: Plan{
+			ProductSlug: m.ProductSlug,
+			Version:     m.Release.Version,
+			state: &state{
+				productFileIDs: map[string]int{},
+				fileGroupIDs:   map[string]int{},
+				userGroupIDs:   map[string]int{},
+			},
+		},
+	}
+
+	steps := []func() error{
+		p.planRelease,
+		p.planProductFiles,
+		p.planFileGroups,
+		p.planReleaseProductFiles,
+		p.planReleaseFileGroups,
+		p.planUserGroups,
+		p.planDependencySpecifiers,
+		p.planUpgradePathSpecifiers,
+	}
+
+	for _, step := range steps {
+		err := step()
+		if err != nil {
+			return Plan{}, err
+		}
+	}
+
+	return p.plan, nil
+}
+
+// Apply makes the changes in plan, in order, stopping at the first error.
+// The plan must come from Plan, as a decoded or hand-built Plan cannot be
+// applied.
+func (r Reconciler) Apply(plan Plan) error {
+	if plan.state == nil {
+		return errPlanNotProduced
+	}
+	for _, c := range plan.Changes {
+		if c.apply == nil {
+			return errPlanNotProduced
+		}
+	}
+
+	for _, c := range plan.Changes {
+		r.logger.Info("Applying change", logger.Data{"change": c.String()})
+
+		err := c.apply(plan.state)
+		if err != nil {
+			return fmt.Errorf("failed to %s: %s", c, err)
+		}
+	}
+
+	return nil
+}
+
+type planner struct {
+	client pivnet.Client
+	m      Manifest
+	plan   Plan
+
+	releaseExists bool
+	groupedFiles  map[string]bool
+}
+
+func (p *planner) add(action Action, resource string, name string, fields []string, apply func(s *state) error) {
+	p.plan.Changes = append(p.plan.Changes, Change{
+		Action:   action,
+		Resource: resource,
+		Name:     name,
+		Fields:   fields,
+		apply:    apply,
+	})
+}
+
+func (p *planner) planRelease() error {
+	slug := p.m.ProductSlug
+
+	releases, err := p.client.Releases.List(slug)
+	if err != nil {
+		return err
+	}
+
+	var releaseID int
+	for _, release := range releases {
+		if release.Version == p.m.Release.Version {
+			releaseID = release.ID
+			break
+		}
+	}
+
+	if releaseID == 0 {
+		config := p.m.Release.ensureConfig(slug).CreateConfig()
+		p.add(ActionCreate, ResourceRelease, p.m.Release.Version, nil, func(s *state) error {
+			release, err := p.client.Releases.Create(config)
+			if err != nil {
+				return err
+			}
+			s.releaseID = release.ID
+			return nil
+		})
+		return nil
+	}
+
+	p.releaseExists = true
+	p.plan.state.releaseID = releaseID
+
+	existing, err := p.client.Releases.Get(slug, releaseID)
+	if err != nil {
+		return err
+	}
+
+	desired := p.m.Release.ensureConfig(slug).ApplyTo(existing)
+	fields := pivnet.ChangedReleaseFields(existing, desired)
+	if len(fields) > 0 {
+		p.add(ActionUpdate, ResourceRelease, p.m.Release.Version, fields, func(s *state) error {
+			_, err := p.client.Releases.Patch(slug, desired, fields...)
+			return err
+		})
+	}
+
+	return nil
+}
+
+func (p *planner) planProductFiles() error {
+	slug := p.m.ProductSlug
+
+	productFiles, err := p.client.ProductFiles.List(slug)
+	if err != nil {
+		return err
+	}
+
+	existingIDs := map[string]int{}
+	for _, productFile := range productFiles {
+		existingIDs[productFile.AWSObjectKey] = productFile.ID
+		p.plan.state.productFileIDs[productFile.AWSObjectKey] = productFile.ID
+	}
+
+	for _, productFile := range p.m.ProductFiles {
+		key := productFile.AWSObjectKey
+
+		id, ok := existingIDs[key]
+		if !ok {
+			config := productFile.createConfig(slug)
+			p.add(ActionCreate, ResourceProductFile, key, nil, func(s *state) error {
+				created, err := p.client.ProductFiles.Create(config)
+				if err != nil {
+					return err
+				}
+				s.productFileIDs[key] = created.ID
+				return nil
+			})
+			continue
+		}
+
+		existing, err := p.client.ProductFiles.Get(slug, id)
+		if err != nil {
+			return err
+		}
+
+		desired := productFile.createConfig(slug).ApplyTo(existing)
+		fields := pivnet.ChangedProductFileFields(existing, desired)
+		if len(fields) > 0 {
+			p.add(ActionUpdate, ResourceProductFile, key, fields, func(s *state) error {
+				_, err := p.client.ProductFiles.Patch(slug, desired, fields...)
+				return err
+			})
+		}
+	}
+
+	return nil
+}
+
+func (p *planner) planFileGroups() error {
+	slug := p.m.ProductSlug
+
+	fileGroups, err := p.client.FileGroups.List(slug)
+	if err != nil {
+		return err
+	}
+
+	existing := map[string]pivnet.FileGroup{}
+	for _, fileGroup := range fileGroups {
+		existing[fileGroup.Name] = fileGroup
+		p.plan.state.fileGroupIDs[fileGroup.Name] = fileGroup.ID
+	}
+
+	keysByID := map[int]string{}
+	for key, id := range p.plan.state.productFileIDs {
+		keysByID[id] = key
+	}
+
+	p.groupedFiles = map[string]bool{}
+
+	for _, fileGroup := range p.m.FileGroups {
+		name := fileGroup.Name
+
+		members := map[string]int{}
+		current, ok := existing[name]
+		if ok {
+			for _, productFile := range current.ProductFiles {
+				key := productFile.AWSObjectKey
+				if key == "" {
+					key = keysByID[productFile.ID]
+				}
+				members[key] = productFile.ID
+			}
+		} else {
+			config := pivnet.CreateFileGroupConfig{ProductSlug: slug, Name: name}
+			p.add(ActionCreate, ResourceFileGroup, name, nil, func(s *state) error {
+				created, err := p.client.FileGroups.Create(config)
+				if err != nil {
+					return err
+				}
+				s.fileGroupIDs[name] = created.ID
+				return nil
+			})
+		}
+
+		desired := map[string]bool{}
+		for _, key := range fileGroup.ProductFiles {
+			key := key
+			desired[key] = true
+			p.groupedFiles[key] = true
+
+			if _, ok := members[key]; ok {
+				continue
+			}
+
+			p.add(ActionCreate, ResourceFileGroupProductFile, name+"/"+key, nil, func(s *state) error {
+				return p.client.ProductFiles.AddToFileGroup(slug, s.fileGroupIDs[name], s.productFileIDs[key])
+			})
+		}
+
+		for _, key := range sortedKeys(members) {
+			if desired[key] {
+				continue
+			}
+
+			id := members[key]
+			p.add(ActionRemove, ResourceFileGroupProductFile, name+"/"+key, nil, func(s *state) error {
+				return p.client.ProductFiles.RemoveFromFileGroup(slug, s.fileGroupIDs[name], id)
+			})
+		}
+	}
+
+	return nil
+}
+
+func (p *planner) planReleaseProductFiles() error {
+	slug := p.m.ProductSlug
+
+	attached := map[string]int{}
+	if p.releaseExists {
+		productFiles, err := p.client.ProductFiles.ListForRelease(slug, p.plan.state.releaseID)
+		if err != nil {
+			return err
+		}
+
+		for _, productFile := range productFiles {
+			attached[productFile.AWSObjectKey] = productFile.ID
+		}
+	}
+
+	desired := map[string]bool{}
+	for _, productFile := range p.m.ProductFiles {
+		key := productFile.AWSObjectKey
+		if p.groupedFiles[key] {
+			continue
+		}
+		desired[key] = true
+
+		if _, ok := attached[key]; ok {
+			continue
+		}
+
+		p.add(ActionCreate, ResourceReleaseProductFile, key, nil, func(s *state) error {
+			return p.client.ProductFiles.AddToRelease(slug, s.releaseID, s.productFileIDs[key])
+		})
+	}
+
+	for _, key := range sortedKeys(attached) {
+		if desired[key] {
+			continue
+		}
+
+		id := attached[key]
+		p.add(ActionRemove, ResourceReleaseProductFile, key, nil, func(s *state) error {
+			return p.client.ProductFiles.RemoveFromRelease(slug, s.releaseID, id)
+		})
+	}
+
+	return nil
+}
+
+func (p *planner) planReleaseFileGroups() error {
+	slug := p.m.ProductSlug
+
+	attached := map[string]int{}
+	if p.releaseExists {
+		fileGroups, err := p.client.FileGroups.ListForRelease(slug, p.plan.state.releaseID)
+		if err != nil {
+			return err
+		}
+
+		for _, fileGroup := range fileGroups {
+			attached[fileGroup.Name] = fileGroup.ID
+		}
+	}
+
+	desired := map[string]bool{}
+	for _, fileGroup := range p.m.FileGroups {
+		name := fileGroup.Name
+		desired[name] = true
+
+		if _, ok := attached[name]; ok {
+			continue
+		}
+
+		p.add(ActionCreate, ResourceReleaseFileGroup, name, nil, func(s *state) error {
+			return p.client.FileGroups.AddToRelease(slug, s.releaseID, s.fileGroupIDs[name])
+		})
+	}
+
+	for _, name := range sortedKeys(attached) {
+		if desired[name] {
+			continue
+		}
+
+		id := attached[name]
+		p.add(ActionRemove, ResourceReleaseFileGroup, name, nil, func(s *state) error {
+			return p.client.FileGroups.RemoveFromRelease(slug, s.releaseID, id)
+		})
+	}
+
+	return nil
+}
+
+func (p *planner) planUserGroups() error {
+	slug := p.m.ProductSlug
+
+	userGroups, err := p.client.UserGroups.List()
+	if err != nil {
+		return err
+	}
+
+	existing := map[string]pivnet.UserGroup{}
+	for _, userGroup := range userGroups {
+		existing[userGroup.Name] = userGroup
+		p.plan.state.userGroupIDs[userGroup.Name] = userGroup.ID
+	}
+
+	attached := map[string]int{}
+	if p.releaseExists {
+		releaseUserGroups, err := p.client.UserGroups.ListForRelease(slug, p.plan.state.releaseID)
+		if err != nil {
+			return err
+		}
+
+		for _, userGroup := range releaseUserGroups {
+			attached[userGroup.Name] = userGroup.ID
+		}
+	}
+
+	desired := map[string]bool{}
+	for _, userGroup := range p.m.UserGroups {
+		name := userGroup.Name
+		description := userGroup.Description
+		desired[name] = true
+
+		current, ok := existing[name]
+		switch {
+		case !ok:
+			p.add(ActionCreate, ResourceUserGroup, name, nil, func(s *state) error {
+				created, err := p.client.UserGroups.Create(name, description, nil)
+				if err != nil {
+					return err
+				}
+				s.userGroupIDs[name] = created.ID
+				return nil
+			})
+		case description != "" && description != current.Description:
+			current.Description = description
+			p.add(ActionUpdate, ResourceUserGroup, name, []string{"description"}, func(s *state) error {
+				_, err := p.client.UserGroups.Update(current)
+				return err
+			})
+		}
+
+		if _, ok := attached[name]; ok {
+			continue
+		}
+
+		p.add(ActionCreate, ResourceReleaseUserGroup, name, nil, func(s *state) error {
+			return p.client.UserGroups.AddToRelease(slug, s.releaseID, s.userGroupIDs[name])
+		})
+	}
+
+	for _, name := range sortedKeys(attached) {
+		if desired[name] {
+			continue
+		}
+
+		id := attached[name]
+		p.add(ActionRemove, ResourceReleaseUserGroup, name, nil, func(s *state) error {
+			return p.client.UserGroups.RemoveFromRelease(slug, s.releaseID, id)
+		})
+	}
+
+	return nil
+}
+
+func (p *planner) planDependencySpecifiers() error {
+	slug := p.m.ProductSlug
+
+	existing := map[string]int{}
+	if p.releaseExists {
+		dependencySpecifiers, err := p.client.DependencySpecifiers.List(slug, p.plan.state.releaseID)
+		if err != nil {
+			return err
+		}
+
+		for _, d := range dependencySpecifiers {
+			existing[d.Product.Slug+" "+d.Specifier] = d.ID
+		}
+	}
+
+	desired := map[string]bool{}
+	for _, d := range p.m.DependencySpecifiers {
+		d := d
+		name := d.ProductSlug + " " + d.Specifier
+		desired[name] = true
+
+		if _, ok := existing[name]; ok {
+			continue
+		}
+
+		p.add(ActionCreate, ResourceDependencySpecifier, name, nil, func(s *state) error {
+			_, err := p.client.DependencySpecifiers.Create(slug, s.releaseID, d.ProductSlug, d.Specifier)
+			return err
+		})
+	}
+
+	for _, name := range sortedKeys(existing) {
+		if desired[name] {
+			continue
+		}
+
+		id := existing[name]
+		p.add(ActionRemove, ResourceDependencySpecifier, name, nil, func(s *state) error {
+			return p.client.DependencySpecifiers.Delete(slug, s.releaseID, id)
+		})
+	}
+
+	return nil
+}
+
+func (p *planner) planUpgradePathSpecifiers() error {
+	slug := p.m.ProductSlug
+
+	existing := map[string]int{}
+	if p.releaseExists {
+		upgradePathSpecifiers, err := p.client.UpgradePathSpecifiers.List(slug, p.plan.state.releaseID)
+		if err != nil {
+			return err
+		}
+
+		for _, u := range upgradePathSpecifiers {
+			existing[u.Specifier] = u.ID
+		}
+	}
+
+	desired := map[string]bool{}
+	for _, specifier := range p.m.UpgradePathSpecifiers {
+		specifier := specifier
+		desired[specifier] = true
+
+		if _, ok := existing[specifier]; ok {
+			continue
+		}
+
+		p.add(ActionCreate, ResourceUpgradePathSpecifier, specifier, nil, func(s *state) error {
+			_, err := p.client.UpgradePathSpecifiers.Create(slug, s.releaseID, specifier)
+			return err
+		})
+	}
+
+	for _, specifier := range sortedKeys(existing) {
+		if desired[specifier] {
+			continue
+		}
+
+		id := existing[specifier]
+		p.add(ActionRemove, ResourceUpgradePathSpecifier, specifier, nil, func(s *state) error {
+			return p.client.UpgradePathSpecifiers.Delete(slug, s.releaseID, id)
+		})
+	}
+
+	return nil
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,323 @@
+package manifest_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/logger/loggerfakes"
+	"github.com/pivotal-cf/go-pivnet/manifest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		server     *ghttp.Server
+		reconciler manifest.Reconciler
+
+		m manifest.Manifest
+	)
+
+	productURL := func(format string, args ...interface{}) string {
+		return fmt.Sprintf("%s/products/%s", apiPrefix, productSlug) + fmt.Sprintf(format, args...)
+	}
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+
+		fakeLogger := &loggerfakes.FakeLogger{}
+		client := pivnet.NewClient(pivnet.ClientConfig{
+			Host:      server.URL(),
+			Token:     "my-auth-token",
+			UserAgent: "pivnet-resource/0.1.0 (some-url)",
+		}, fakeLogger)
+
+		reconciler = manifest.NewReconciler(client, fakeLogger)
+
+		var err error
+		m, err = manifest.LoadFile("fixtures/release.yml")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("when the release does not exist", func() {
+		BeforeEach(func() {
+			server.RouteToHandler("GET", productURL("/releases"),
+				ghttp.RespondWith(http.StatusOK, `{"releases":[{"id":41,"version":"1.2.2"}]}`))
+			server.RouteToHandler("GET", productURL("/product_files"),
+				ghttp.RespondWith(http.StatusOK, `{"product_files":[]}`))
+			server.RouteToHandler("GET", productURL("/file_groups"),
+				ghttp.RespondWith(http.StatusOK, `{"file_groups":[]}`))
+			server.RouteToHandler("GET", apiPrefix+"/user_groups",
+				ghttp.RespondWith(http.StatusOK, `{"user_groups":[{"id":5,"name":"Early Access"}]}`))
+		})
+
+		It("plans to create everything", func() {
+			plan, err := reconciler.Plan(m)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(plan.HasChanges()).To(BeTrue())
+			Expect(plan.String()).To(Equal(`create release '1.2.3'
+create product_file 'product-files/some-product/installer.zip'
+create product_file 'product-files/some-product/docs.pdf'
+create file_group 'Documentation'
+create file_group_product_file 'Documentation/product-files/some-product/docs.pdf'
+create release_product_file 'product-files/some-product/installer.zip'
+create release_file_group 'Documentation'
+create release_user_group 'Early Access'
+create dependency_specifier 'other-product 2.0.*'
+create upgrade_path_specifier '1.1.*'`))
+		})
+
+		It("applies the plan using the IDs of created resources", func() {
+			plan, err := reconciler.Plan(m)
+			Expect(err).NotTo(HaveOccurred())
+
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", productURL("/releases")),
+					ghttp.RespondWith(http.StatusCreated, `{"release":{"id":42,"version":"1.2.3"}}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", productURL("/product_files")),
+					ghttp.RespondWith(http.StatusCreated, `{"product_file":{"id":100}}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", productURL("/product_files")),
+					ghttp.RespondWith(http.StatusCreated, `{"product_file":{"id":101}}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", productURL("/file_groups")),
+					ghttp.RespondWith(http.StatusCreated, `{"id":7,"name":"Documentation"}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PATCH", productURL("/file_groups/7/add_product_file")),
+					ghttp.VerifyJSON(`{"product_file":{"id":101}}`),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PATCH", productURL("/releases/42/add_product_file")),
+					ghttp.VerifyJSON(`{"product_file":{"id":100}}`),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PATCH", productURL("/releases/42/add_file_group")),
+					ghttp.VerifyJSON(`{"file_group":{"id":7}}`),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PATCH", productURL("/releases/42/add_user_group")),
+					ghttp.VerifyJSON(`{"user_group":{"id":5}}`),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", productURL("/releases/42/dependency_specifiers")),
+					ghttp.VerifyJSON(`{"dependency_specifier":{"product_slug":"other-product","specifier":"2.0.*"}}`),
+					ghttp.RespondWith(http.StatusCreated, `{"dependency_specifier":{"id":3}}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", productURL("/releases/42/upgrade_path_specifiers")),
+					ghttp.VerifyJSON(`{"upgrade_path_specifier":{"specifier":"1.1.*"}}`),
+					ghttp.RespondWith(http.StatusCreated, `{"upgrade_path_specifier":{"id":4}}`),
+				),
+			)
+
+			err = reconciler.Apply(plan)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when a change fails to apply", func() {
+			It("stops and returns the error naming the change", func() {
+				plan, err := reconciler.Plan(m)
+				Expect(err).NotTo(HaveOccurred())
+
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", productURL("/releases")),
+						ghttp.RespondWith(http.StatusTeapot, `{"message":"foo message"}`),
+					),
+				)
+
+				err = reconciler.Apply(plan)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("failed to create release '1.2.3'"))
+				Expect(err.Error()).To(ContainSubstring("foo message"))
+			})
+		})
+	})
+
+	Context("when the release already exists", func() {
+		BeforeEach(func() {
+			server.RouteToHandler("GET", productURL("/releases"),
+				ghttp.RespondWith(http.StatusOK, `{"releases":[{"id":42,"version":"1.2.3"}]}`))
+			server.RouteToHandler("GET", productURL("/releases/42"),
+				ghttp.RespondWith(http.StatusOK, `{
+					"id":42,
+					"version":"1.2.3",
+					"release_type":"Major Release",
+					"eula":{"slug":"some-eula"},
+					"release_notes_url":"https://example.com/notes",
+					"controlled":true
+				}`))
+			server.RouteToHandler("GET", productURL("/product_files"),
+				ghttp.RespondWith(http.StatusOK, `{"product_files":[
+					{"id":100,"aws_object_key":"product-files/some-product/installer.zip"},
+					{"id":102,"aws_object_key":"product-files/some-product/stale.zip"}
+				]}`))
+			server.RouteToHandler("GET", productURL("/product_files/100"),
+				ghttp.RespondWith(http.StatusOK, `{"product_file":{
+					"id":100,
+					"aws_object_key":"product-files/some-product/installer.zip",
+					"name":"Installer",
+					"file_type":"Software",
+					"sha256":"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+					"platforms":["Linux"]
+				}}`))
+			server.RouteToHandler("GET", productURL("/file_groups"),
+				ghttp.RespondWith(http.StatusOK, `{"file_groups":[{"id":7,"name":"Documentation","product_files":[{"id":102}]}]}`))
+			server.RouteToHandler("GET", productURL("/releases/42/product_files"),
+				ghttp.RespondWith(http.StatusOK, `{"product_files":[
+					{"id":100,"aws_object_key":"product-files/some-product/installer.zip"},
+					{"id":102,"aws_object_key":"product-files/some-product/stale.zip"}
+				]}`))
+			server.RouteToHandler("GET", productURL("/releases/42/file_groups"),
+				ghttp.RespondWith(http.StatusOK, `{"file_groups":[{"id":7,"name":"Documentation"}]}`))
+			server.RouteToHandler("GET", apiPrefix+"/user_groups",
+				ghttp.RespondWith(http.StatusOK, `{"user_groups":[]}`))
+			server.RouteToHandler("GET", productURL("/releases/42/user_groups"),
+				ghttp.RespondWith(http.StatusOK, `{"user_groups":[{"id":9,"name":"Old Group"}]}`))
+			server.RouteToHandler("GET", productURL("/releases/42/dependency_specifiers"),
+				ghttp.RespondWith(http.StatusOK, `{"dependency_specifiers":[{"id":3,"product":{"slug":"other-product"},"specifier":"2.0.*"}]}`))
+			server.RouteToHandler("GET", productURL("/releases/42/upgrade_path_specifiers"),
+				ghttp.RespondWith(http.StatusOK, `{"upgrade_path_specifiers":[{"id":4,"specifier":"1.0.*"}]}`))
+		})
+
+		It("plans only the differences", func() {
+			plan, err := reconciler.Plan(m)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(plan.String()).To(Equal(`update release '1.2.3' (release_type)
+create product_file 'product-files/some-product/docs.pdf'
+create file_group_product_file 'Documentation/product-files/some-product/docs.pdf'
+remove file_group_product_file 'Documentation/product-files/some-product/stale.zip'
+remove release_product_file 'product-files/some-product/stale.zip'
+create user_group 'Early Access'
+create release_user_group 'Early Access'
+remove release_user_group 'Old Group'
+create upgrade_path_specifier '1.1.*'
+remove upgrade_path_specifier '1.0.*'`))
+
+			Expect(plan.Changes[0].Action).To(Equal(manifest.ActionUpdate))
+			Expect(plan.Changes[0].Resource).To(Equal(manifest.ResourceRelease))
+			Expect(plan.Changes[0].Fields).To(Equal([]string{pivnet.ReleaseFieldReleaseType}))
+		})
+
+		It("updates controlled only when the manifest sets it", func() {
+			controlled := false
+			m.Release.Controlled = &controlled
+
+			plan, err := reconciler.Plan(m)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(plan.Changes[0].Fields).To(Equal([]string{
+				pivnet.ReleaseFieldControlled,
+				pivnet.ReleaseFieldReleaseType,
+			}))
+		})
+
+		It("applies removals using the existing IDs", func() {
+			m.ProductFiles = m.ProductFiles[:1]
+			m.FileGroups = nil
+			m.UserGroups = nil
+			m.UpgradePathSpecifiers = []string{"1.0.*"}
+			m.Release.ReleaseType = "Major Release"
+
+			plan, err := reconciler.Plan(m)
+			Expect(err).NotTo(HaveOccurred())
+
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PATCH", productURL("/releases/42/remove_product_file")),
+					ghttp.VerifyJSON(`{"product_file":{"id":102}}`),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PATCH", productURL("/releases/42/remove_file_group")),
+					ghttp.VerifyJSON(`{"file_group":{"id":7}}`),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PATCH", productURL("/releases/42/remove_user_group")),
+					ghttp.VerifyJSON(`{"user_group":{"id":9}}`),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+
+			Expect(plan.Changes).To(HaveLen(3))
+
+			err = reconciler.Apply(plan)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("when the plan was not produced by Plan", func() {
+		BeforeEach(func() {
+			server.RouteToHandler("GET", productURL("/releases"),
+				ghttp.RespondWith(http.StatusOK, `{"releases":[]}`))
+			server.RouteToHandler("GET", productURL("/product_files"),
+				ghttp.RespondWith(http.StatusOK, `{"product_files":[]}`))
+			server.RouteToHandler("GET", productURL("/file_groups"),
+				ghttp.RespondWith(http.StatusOK, `{"file_groups":[]}`))
+			server.RouteToHandler("GET", apiPrefix+"/user_groups",
+				ghttp.RespondWith(http.StatusOK, `{"user_groups":[{"id":5,"name":"Early Access"}]}`))
+		})
+
+		It("returns an error for a decoded plan without making a request", func() {
+			plan, err := reconciler.Plan(m)
+			Expect(err).NotTo(HaveOccurred())
+
+			b, err := json.Marshal(plan)
+			Expect(err).NotTo(HaveOccurred())
+
+			var decoded manifest.Plan
+			Expect(json.Unmarshal(b, &decoded)).To(Succeed())
+			Expect(decoded.Changes).To(HaveLen(len(plan.Changes)))
+
+			requests := len(server.ReceivedRequests())
+
+			err = reconciler.Apply(decoded)
+			Expect(err).To(MatchError("plan was not produced by Reconciler.Plan"))
+			Expect(server.ReceivedRequests()).To(HaveLen(requests))
+		})
+
+		It("returns an error for a hand-built plan", func() {
+			err := reconciler.Apply(manifest.Plan{
+				ProductSlug: productSlug,
+				Version:     "1.2.3",
+				Changes: []manifest.Change{
+					{Action: manifest.ActionCreate, Resource: manifest.ResourceRelease, Name: "1.2.3"},
+				},
+			})
+			Expect(err).To(MatchError("plan was not produced by Reconciler.Plan"))
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+
+	Context("when the manifest is invalid", func() {
+		It("returns an error without making a request", func() {
+			m.Release.Version = ""
+
+			_, err := reconciler.Plan(m)
+			Expect(err).To(HaveOccurred())
+
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+})
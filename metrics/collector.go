@@ -0,0 +1,252 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Metric names, shared with other Observers.
+const (
+	RequestsName         = "pivnet_api_requests_total"
+	RequestDurationName  = "pivnet_api_request_duration_seconds"
+	RetriesName          = "pivnet_download_retries_total"
+	LinkRefreshesName    = "pivnet_download_link_refreshes_total"
+	BytesName            = "pivnet_download_bytes_total"
+	RangeThroughputName  = "pivnet_download_range_throughput_bytes_per_second"
+	ChecksumFailuresName = "pivnet_download_checksum_failures_total"
+)
+
+var help = map[string]string{
+	RequestsName:         "API requests by method, endpoint template and status.",
+	RequestDurationName:  "API request latency by method, endpoint template and status.",
+	RetriesName:          "Download range retries by reason.",
+	LinkRefreshesName:    "Expired download links replaced during downloads.",
+	BytesName:            "Bytes of product files downloaded.",
+	RangeThroughputName:  "Throughput of each downloaded byte range.",
+	ChecksumFailuresName: "Downloaded files not matching their published checksum.",
+}
+
+// Help returns the description of the named metric.
+func Help(name string) string {
+	return help[name]
+}
+
+var (
+	DurationBuckets   = []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30}
+	ThroughputBuckets = []float64{1 << 16, 1 << 18, 1 << 20, 1 << 22, 1 << 24, 1 << 26, 1 << 28}
+)
+
+type requestKey struct {
+	method   string
+	endpoint string
+	status   string
+}
+
+// Collector is an Observer keeping metrics in memory.
+type Collector struct {
+	mutex sync.Mutex
+
+	requests         map[requestKey]*histogram
+	retries          map[string]float64
+	linkRefreshes    float64
+	bytes            float64
+	rangeThroughput  *histogram
+	checksumFailures float64
+}
+
+func NewCollector() *Collector {
+	return &Collector{
+		requests:        map[requestKey]*histogram{},
+		retries:         map[string]float64{},
+		rangeThroughput: newHistogram(ThroughputBuckets),
+	}
+}
+
+func (c *Collector) Request(method string, endpoint string, status int, duration time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	key := requestKey{method: method, endpoint: endpoint, status: StatusLabel(status)}
+	h, ok := c.requests[key]
+	if !ok {
+		h = newHistogram(DurationBuckets)
+		c.requests[key] = h
+	}
+	h.observe(duration.Seconds())
+}
+
+func (c *Collector) Retry(reason string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.retries[reason]++
+}
+
+func (c *Collector) LinkRefresh() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.linkRefreshes++
+}
+
+func (c *Collector) RangeDownloaded(bytes int64, duration time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.bytes += float64(bytes)
+	if duration > 0 {
+		c.rangeThroughput.observe(float64(bytes) / duration.Seconds())
+	}
+}
+
+func (c *Collector) ChecksumFailure() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.checksumFailures++
+}
+
+// ServeHTTP writes the metrics in the Prometheus text format.
+func (c *Collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	c.Write(w)
+}
+
+// Write writes the metrics in the Prometheus text format.
+func (c *Collector) Write(w io.Writer) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	b := bufio.NewWriter(w)
+
+	keys := c.requestKeys()
+
+	writeHeader(b, RequestsName, "counter")
+	for _, key := range keys {
+		writeSample(b, RequestsName, key.labels(), float64(c.requests[key].count))
+	}
+
+	writeHeader(b, RequestDurationName, "histogram")
+	for _, key := range keys {
+		c.requests[key].write(b, RequestDurationName, key.labels())
+	}
+
+	writeHeader(b, RetriesName, "counter")
+	for _, reason := range sortedKeys(c.retries) {
+		writeSample(b, RetriesName, []string{"reason", reason}, c.retries[reason])
+	}
+
+	writeHeader(b, LinkRefreshesName, "counter")
+	writeSample(b, LinkRefreshesName, nil, c.linkRefreshes)
+
+	writeHeader(b, BytesName, "counter")
+	writeSample(b, BytesName, nil, c.bytes)
+
+	writeHeader(b, RangeThroughputName, "histogram")
+	c.rangeThroughput.write(b, RangeThroughputName, nil)
+
+	writeHeader(b, ChecksumFailuresName, "counter")
+	writeSample(b, ChecksumFailuresName, nil, c.checksumFailures)
+
+	return b.Flush()
+}
+
+func (c *Collector) requestKeys() []requestKey {
+	var keys []requestKey
+	for key := range c.requests {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return strings.Join(keys[i].labels(), "\x00") < strings.Join(keys[j].labels(), "\x00")
+	})
+	return keys
+}
+
+func (k requestKey) labels() []string {
+	return []string{"method", k.method, "endpoint", k.endpoint, "status", k.status}
+}
+
+// StatusLabel returns the status label of a request, which is "error" when
+// no response was received.
+func StatusLabel(status int) string {
+	if status == 0 {
+		return "error"
+	}
+	return strconv.Itoa(status)
+}
+
+type histogram struct {
+	buckets []float64
+	counts  []uint64
+	count   uint64
+	sum     float64
+}
+
+func newHistogram(buckets []float64) *histogram {
+	return &histogram{
+		buckets: buckets,
+		counts:  make([]uint64, len(buckets)),
+	}
+}
+
+func (h *histogram) observe(v float64) {
+	for i, upper := range h.buckets {
+		if v <= upper {
+			h.counts[i]++
+		}
+	}
+	h.count++
+	h.sum += v
+}
+
+// cumulative returns the count of observations at or below each bucket.
+func (h *histogram) cumulative() map[float64]uint64 {
+	buckets := map[float64]uint64{}
+	for i, upper := range h.buckets {
+		buckets[upper] = h.counts[i]
+	}
+	return buckets
+}
+
+func (h *histogram) write(w io.Writer, name string, labels []string) {
+	for i, upper := range h.buckets {
+		le := strconv.FormatFloat(upper, 'g', -1, 64)
+		writeSample(w, name+"_bucket", append(append([]string{}, labels...), "le", le), float64(h.counts[i]))
+	}
+	writeSample(w, name+"_bucket", append(append([]string{}, labels...), "le", "+Inf"), float64(h.count))
+	writeSample(w, name+"_sum", labels, h.sum)
+	writeSample(w, name+"_count", labels, float64(h.count))
+}
+
+func writeHeader(w io.Writer, name string, kind string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help[name], name, kind)
+}
+
+// writeSample writes one sample, with labels given as name/value pairs.
+func writeSample(w io.Writer, name string, labels []string, value float64) {
+	fmt.Fprint(w, name)
+	if len(labels) > 0 {
+		var pairs []string
+		for i := 0; i < len(labels); i += 2 {
+			pairs = append(pairs, fmt.Sprintf("%s=%s", labels[i], strconv.Quote(labels[i+1])))
+		}
+		fmt.Fprintf(w, "{%s}", strings.Join(pairs, ","))
+	}
+	fmt.Fprintf(w, " %s\n", strconv.FormatFloat(value, 'g', -1, 64))
+}
+
+func sortedKeys(m map[string]float64) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
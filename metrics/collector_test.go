@@ -0,0 +1,90 @@
+package metrics_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet/metrics"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Collector", func() {
+	var collector *metrics.Collector
+
+	BeforeEach(func() {
+		collector = metrics.NewCollector()
+	})
+
+	write := func() string {
+		output := &bytes.Buffer{}
+		Expect(collector.Write(output)).To(Succeed())
+		return output.String()
+	}
+
+	It("counts requests by method, endpoint and status", func() {
+		collector.Request("GET", "/products/:product_slug", 200, 200*time.Millisecond)
+		collector.Request("GET", "/products/:product_slug", 200, 2*time.Second)
+		collector.Request("POST", "/products/:product_slug/releases", 0, time.Second)
+
+		output := write()
+		Expect(output).To(ContainSubstring("# TYPE pivnet_api_requests_total counter\n"))
+		Expect(output).To(ContainSubstring(`pivnet_api_requests_total{method="GET",endpoint="/products/:product_slug",status="200"} 2`))
+		Expect(output).To(ContainSubstring(`pivnet_api_requests_total{method="POST",endpoint="/products/:product_slug/releases",status="error"} 1`))
+
+		Expect(output).To(ContainSubstring("# TYPE pivnet_api_request_duration_seconds histogram\n"))
+		Expect(output).To(ContainSubstring(`pivnet_api_request_duration_seconds_bucket{method="GET",endpoint="/products/:product_slug",status="200",le="0.25"} 1`))
+		Expect(output).To(ContainSubstring(`pivnet_api_request_duration_seconds_bucket{method="GET",endpoint="/products/:product_slug",status="200",le="2.5"} 2`))
+		Expect(output).To(ContainSubstring(`pivnet_api_request_duration_seconds_bucket{method="GET",endpoint="/products/:product_slug",status="200",le="+Inf"} 2`))
+		Expect(output).To(ContainSubstring(`pivnet_api_request_duration_seconds_sum{method="GET",endpoint="/products/:product_slug",status="200"} 2.2`))
+	})
+
+	It("counts download retries, link refreshes, bytes and checksum failures", func() {
+		collector.Retry(metrics.RetryConnectionReset)
+		collector.Retry(metrics.RetryConnectionReset)
+		collector.LinkRefresh()
+		collector.RangeDownloaded(1<<20, time.Second)
+		collector.ChecksumFailure()
+
+		output := write()
+		Expect(output).To(ContainSubstring(`pivnet_download_retries_total{reason="connection_reset"} 2`))
+		Expect(output).To(ContainSubstring("pivnet_download_link_refreshes_total 1\n"))
+		Expect(output).To(ContainSubstring("pivnet_download_bytes_total 1.048576e+06\n"))
+		Expect(output).To(ContainSubstring(`pivnet_download_range_throughput_bytes_per_second_bucket{le="262144"} 0`))
+		Expect(output).To(ContainSubstring(`pivnet_download_range_throughput_bytes_per_second_bucket{le="1.048576e+06"} 1`))
+		Expect(output).To(ContainSubstring("pivnet_download_checksum_failures_total 1\n"))
+	})
+
+	It("writes the same output for the same metrics", func() {
+		collector.Request("GET", "/b", 200, time.Second)
+		collector.Request("GET", "/a", 200, time.Second)
+		collector.Retry("b")
+		collector.Retry("a")
+
+		Expect(write()).To(Equal(write()))
+	})
+
+	It("serves the metrics over HTTP", func() {
+		collector.LinkRefresh()
+
+		recorder := httptest.NewRecorder()
+		collector.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Header().Get("Content-Type")).To(ContainSubstring("text/plain"))
+		Expect(recorder.Body.String()).To(ContainSubstring("pivnet_download_link_refreshes_total 1\n"))
+	})
+})
+
+var _ = Describe("EndpointTemplate", func() {
+	It("replaces IDs and slugs with placeholders", func() {
+		Expect(metrics.EndpointTemplate("/products/my-product/releases/1234/product_files/5?x=1")).
+			To(Equal("/products/:product_slug/releases/:id/product_files/:id"))
+		Expect(metrics.EndpointTemplate("/eulas/pivotal_beta_eula")).To(Equal("/eulas/:eula_slug"))
+		Expect(metrics.EndpointTemplate("/products")).To(Equal("/products"))
+		Expect(metrics.EndpointTemplate("/releases/release_types")).To(Equal("/releases/release_types"))
+	})
+})
@@ -0,0 +1,81 @@
+// Package metrics instruments API requests and product file downloads
+// without depending on a metrics library.
+//
+// Set a Collector as pivnet.ClientConfig.Metrics and serve it to
+// Prometheus as an http.Handler, or use the Observer in the prometheus
+// subpackage to register the metrics with client_golang.
+package metrics
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Observer is notified by the client as requests and downloads happen.
+type Observer interface {
+	// Request is called for each API request. status is 0 if no response
+	// was received.
+	Request(method string, endpoint string, status int, duration time.Duration)
+
+	// Retry is called when part of a download is retried.
+	Retry(reason string)
+
+	// LinkRefresh is called when an expired download link is replaced.
+	LinkRefresh()
+
+	// RangeDownloaded is called when a byte range of a download completes.
+	RangeDownloaded(bytes int64, duration time.Duration)
+
+	// ChecksumFailure is called when a downloaded file does not match its
+	// published checksum.
+	ChecksumFailure()
+}
+
+const (
+	RetryNetworkError    = "network_error"
+	RetryUnexpectedEOF   = "unexpected_eof"
+	RetryConnectionReset = "connection_reset"
+)
+
+// EndpointTemplate replaces the IDs and slugs in an API path with
+// placeholders, so requests for different resources are counted together.
+func EndpointTemplate(path string) string {
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if segment == "" {
+			continue
+		}
+
+		if _, err := strconv.Atoi(segment); err == nil {
+			segments[i] = ":id"
+			continue
+		}
+
+		if i > 0 {
+			switch segments[i-1] {
+			case "products":
+				segments[i] = ":product_slug"
+			case "eulas":
+				segments[i] = ":eula_slug"
+			}
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// Discard is an Observer that ignores everything.
+var Discard Observer = discard{}
+
+type discard struct{}
+
+func (discard) Request(string, string, int, time.Duration) {}
+func (discard) Retry(string)                               {}
+func (discard) LinkRefresh()                               {}
+func (discard) RangeDownloaded(int64, time.Duration)       {}
+func (discard) ChecksumFailure()                           {}
@@ -0,0 +1,112 @@
+// Package prometheus provides a metrics.Observer recording the client's
+// metrics with the Prometheus client library.
+package prometheus
+
+import (
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Observer is a metrics.Observer and a prometheus.Collector.
+type Observer struct {
+	requests         *prometheus.CounterVec
+	requestDuration  *prometheus.HistogramVec
+	retries          *prometheus.CounterVec
+	linkRefreshes    prometheus.Counter
+	bytes            prometheus.Counter
+	rangeThroughput  prometheus.Histogram
+	checksumFailures prometheus.Counter
+}
+
+func NewObserver() *Observer {
+	requestLabels := []string{"method", "endpoint", "status"}
+
+	return &Observer{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: metrics.RequestsName,
+			Help: metrics.Help(metrics.RequestsName),
+		}, requestLabels),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    metrics.RequestDurationName,
+			Help:    metrics.Help(metrics.RequestDurationName),
+			Buckets: metrics.DurationBuckets,
+		}, requestLabels),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: metrics.RetriesName,
+			Help: metrics.Help(metrics.RetriesName),
+		}, []string{"reason"}),
+		linkRefreshes: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: metrics.LinkRefreshesName,
+			Help: metrics.Help(metrics.LinkRefreshesName),
+		}),
+		bytes: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: metrics.BytesName,
+			Help: metrics.Help(metrics.BytesName),
+		}),
+		rangeThroughput: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    metrics.RangeThroughputName,
+			Help:    metrics.Help(metrics.RangeThroughputName),
+			Buckets: metrics.ThroughputBuckets,
+		}),
+		checksumFailures: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: metrics.ChecksumFailuresName,
+			Help: metrics.Help(metrics.ChecksumFailuresName),
+		}),
+	}
+}
+
+func (o *Observer) Request(method string, endpoint string, status int, duration time.Duration) {
+	labels := prometheus.Labels{
+		"method":   method,
+		"endpoint": endpoint,
+		"status":   metrics.StatusLabel(status),
+	}
+
+	o.requests.With(labels).Inc()
+	o.requestDuration.With(labels).Observe(duration.Seconds())
+}
+
+func (o *Observer) Retry(reason string) {
+	o.retries.WithLabelValues(reason).Inc()
+}
+
+func (o *Observer) LinkRefresh() {
+	o.linkRefreshes.Inc()
+}
+
+func (o *Observer) RangeDownloaded(bytes int64, duration time.Duration) {
+	o.bytes.Add(float64(bytes))
+	if duration > 0 {
+		o.rangeThroughput.Observe(float64(bytes) / duration.Seconds())
+	}
+}
+
+func (o *Observer) ChecksumFailure() {
+	o.checksumFailures.Inc()
+}
+
+func (o *Observer) Describe(ch chan<- *prometheus.Desc) {
+	for _, c := range o.collectors() {
+		c.Describe(ch)
+	}
+}
+
+func (o *Observer) Collect(ch chan<- prometheus.Metric) {
+	for _, c := range o.collectors() {
+		c.Collect(ch)
+	}
+}
+
+func (o *Observer) collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		o.requests,
+		o.requestDuration,
+		o.retries,
+		o.linkRefreshes,
+		o.bytes,
+		o.rangeThroughput,
+		o.checksumFailures,
+	}
+}
@@ -0,0 +1,77 @@
+package prometheus_test
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet/metrics"
+	pivnetprometheus "github.com/pivotal-cf/go-pivnet/metrics/prometheus"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Observer", func() {
+	var (
+		observer *pivnetprometheus.Observer
+		registry *prometheus.Registry
+	)
+
+	BeforeEach(func() {
+		observer = pivnetprometheus.NewObserver()
+
+		registry = prometheus.NewRegistry()
+		Expect(registry.Register(observer)).To(Succeed())
+	})
+
+	It("is a metrics.Observer", func() {
+		var _ metrics.Observer = observer
+	})
+
+	It("records API requests by method, endpoint and status", func() {
+		observer.Request("GET", "/products/:product_slug", 200, 100*time.Millisecond)
+		observer.Request("GET", "/products/:product_slug", 200, 2*time.Second)
+		observer.Request("POST", "/products/:product_slug/releases", 0, time.Second)
+
+		Expect(testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP pivnet_api_requests_total API requests by method, endpoint template and status.
+# TYPE pivnet_api_requests_total counter
+pivnet_api_requests_total{endpoint="/products/:product_slug",method="GET",status="200"} 2
+pivnet_api_requests_total{endpoint="/products/:product_slug/releases",method="POST",status="error"} 1
+`), metrics.RequestsName)).To(Succeed())
+
+		Expect(testutil.CollectAndCount(observer, metrics.RequestDurationName)).To(Equal(2))
+	})
+
+	It("records download retries, link refreshes, bytes and checksum failures", func() {
+		observer.Retry(metrics.RetryUnexpectedEOF)
+		observer.LinkRefresh()
+		observer.RangeDownloaded(1024, time.Second)
+		observer.RangeDownloaded(1024, 0)
+		observer.ChecksumFailure()
+
+		Expect(testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP pivnet_download_bytes_total Bytes of product files downloaded.
+# TYPE pivnet_download_bytes_total counter
+pivnet_download_bytes_total 2048
+# HELP pivnet_download_checksum_failures_total Downloaded files not matching their published checksum.
+# TYPE pivnet_download_checksum_failures_total counter
+pivnet_download_checksum_failures_total 1
+# HELP pivnet_download_link_refreshes_total Expired download links replaced during downloads.
+# TYPE pivnet_download_link_refreshes_total counter
+pivnet_download_link_refreshes_total 1
+# HELP pivnet_download_retries_total Download range retries by reason.
+# TYPE pivnet_download_retries_total counter
+pivnet_download_retries_total{reason="unexpected_eof"} 1
+`),
+			metrics.BytesName,
+			metrics.ChecksumFailuresName,
+			metrics.LinkRefreshesName,
+			metrics.RetriesName,
+		)).To(Succeed())
+
+		Expect(testutil.CollectAndCount(observer, metrics.RangeThroughputName)).To(Equal(1))
+	})
+})
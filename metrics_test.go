@@ -0,0 +1,57 @@
+package pivnet_test
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/logger/loggerfakes"
+	"github.com/pivotal-cf/go-pivnet/metrics"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PivnetClient - metrics", func() {
+	var (
+		server    *ghttp.Server
+		collector *metrics.Collector
+		client    pivnet.Client
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		collector = metrics.NewCollector()
+
+		client = pivnet.NewClient(pivnet.ClientConfig{
+			Host:    server.URL(),
+			Token:   "my-auth-token",
+			Metrics: collector,
+		}, &loggerfakes.FakeLogger{})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("records API requests by endpoint template and status", func() {
+		server.RouteToHandler("GET", apiPrefix+"/products/some-product/releases/1234",
+			ghttp.RespondWith(http.StatusOK, `{"id":1234}`))
+		server.RouteToHandler("GET", apiPrefix+"/products/other-product/releases/5678",
+			ghttp.RespondWith(http.StatusNotFound, `{"message":"not found"}`))
+
+		_, err := client.Releases.Get("some-product", 1234)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = client.Releases.Get("other-product", 5678)
+		Expect(err).To(HaveOccurred())
+
+		output := &bytes.Buffer{}
+		Expect(collector.Write(output)).To(Succeed())
+		Expect(output.String()).To(ContainSubstring(
+			`pivnet_api_requests_total{method="GET",endpoint="/products/:product_slug/releases/:id",status="200"} 1`))
+		Expect(output.String()).To(ContainSubstring(
+			`pivnet_api_requests_total{method="GET",endpoint="/products/:product_slug/releases/:id",status="404"} 1`))
+	})
+})
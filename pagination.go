@@ -0,0 +1,63 @@
+package pivnet
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// listPages requests endpoint and each page linked from it as next,
+// passing each response body to page until there is no next page.
+func (c Client) listPages(ctx context.Context, endpoint string, page func(body io.Reader) (*Links, error)) error {
+	seen := map[string]bool{}
+
+	for endpoint != "" {
+		if seen[endpoint] {
+			return fmt.Errorf("pagination loop: next page '%s' was already listed", endpoint)
+		}
+		seen[endpoint] = true
+
+		resp, err := c.MakeRequestWithContext(ctx, "GET", endpoint, http.StatusOK, nil)
+		if err != nil {
+			return err
+		}
+
+		links, err := page(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		// Links relative to the host include the API version, which
+		// MakeRequest adds again
+		endpoint = strings.TrimPrefix(nextPage(resp, links), apiVersion)
+	}
+
+	return nil
+}
+
+// nextPage returns the next link from the _links of the body, or else
+// from the Link header.
+func nextPage(resp *http.Response, links *Links) string {
+	if links != nil && links.Next["href"] != "" {
+		return links.Next["href"]
+	}
+
+	for _, header := range resp.Header["Link"] {
+		for _, link := range strings.Split(header, ",") {
+			parts := strings.Split(link, ";")
+			target := strings.Trim(strings.TrimSpace(parts[0]), "<>")
+
+			for _, param := range parts[1:] {
+				param = strings.Replace(strings.TrimSpace(param), " ", "", -1)
+				if param == `rel="next"` || param == "rel=next" {
+					return target
+				}
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,114 @@
+package pivnet_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/logger/loggerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PivnetClient - pagination", func() {
+	var (
+		server *ghttp.Server
+		client pivnet.Client
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		client = pivnet.NewClient(pivnet.ClientConfig{
+			Host:  server.URL(),
+			Token: "my-auth-token",
+		}, &loggerfakes.FakeLogger{})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	releasesURL := apiPrefix + "/products/some-product/releases"
+
+	Context("when the response links to a next page", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", releasesURL),
+					ghttp.RespondWith(http.StatusOK, fmt.Sprintf(
+						`{"releases":[{"id":1},{"id":2}],"_links":{"next":{"href":"%s%s?page=2"}}}`,
+						server.URL(), releasesURL,
+					)),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", releasesURL, "page=2"),
+					ghttp.VerifyHeaderKV("Authorization", "Token my-auth-token"),
+					ghttp.RespondWith(http.StatusOK, `{"releases":[{"id":3}]}`),
+				),
+			)
+		})
+
+		It("lists every page", func() {
+			releases, err := client.Releases.List("some-product")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(releases).To(Equal([]pivnet.Release{{ID: 1}, {ID: 2}, {ID: 3}}))
+		})
+
+		It("iterates over each page", func() {
+			var pages [][]pivnet.Release
+			err := client.Releases.ListIter("some-product", func(page []pivnet.Release) error {
+				pages = append(pages, page)
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pages).To(Equal([][]pivnet.Release{{{ID: 1}, {ID: 2}}, {{ID: 3}}}))
+		})
+
+		It("stops iterating when the callback returns an error", func() {
+			stop := errors.New("stop")
+
+			calls := 0
+			err := client.Releases.ListIter("some-product", func(page []pivnet.Release) error {
+				calls++
+				return stop
+			})
+			Expect(err).To(Equal(stop))
+			Expect(calls).To(Equal(1))
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	It("follows next links in the Link header", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", apiPrefix+"/user_groups"),
+				ghttp.RespondWith(http.StatusOK, `{"user_groups":[{"id":1}]}`, http.Header{
+					"Link": {fmt.Sprintf(`<%s/user_groups?page=1>; rel="prev", <%s/user_groups?page=2>; rel="next"`, apiPrefix, apiPrefix)},
+				}),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", apiPrefix+"/user_groups", "page=2"),
+				ghttp.RespondWith(http.StatusOK, `{"user_groups":[{"id":2}]}`),
+			),
+		)
+
+		userGroups, err := client.UserGroups.List()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(userGroups).To(Equal([]pivnet.UserGroup{{ID: 1}, {ID: 2}}))
+	})
+
+	It("returns an error when a next link repeats a page", func() {
+		server.RouteToHandler("GET", apiPrefix+"/products/some-product/product_files",
+			ghttp.RespondWith(http.StatusOK, fmt.Sprintf(
+				`{"product_files":[{"id":1}],"_links":{"next":{"href":"%s/products/some-product/product_files"}}}`,
+				apiPrefix,
+			)),
+		)
+
+		_, err := client.ProductFiles.List("some-product")
+		Expect(err).To(MatchError(ContainSubstring("pagination loop")))
+	})
+})
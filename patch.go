@@ -0,0 +1,56 @@
+package pivnet
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+type patchBody map[string]map[string]interface{}
+
+// maskFields returns the subset of values named by fields.
+// It returns an error if fields is empty or names an unknown field.
+func maskFields(
+	resourceName string,
+	values map[string]interface{},
+	fields []string,
+) (map[string]interface{}, error) {
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("at least one %s field must be provided", resourceName)
+	}
+
+	masked := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		value, ok := values[field]
+		if !ok {
+			return nil, fmt.Errorf("unknown %s field: '%s'", resourceName, field)
+		}
+		masked[field] = value
+	}
+
+	return masked, nil
+}
+
+// changedFields returns the sorted names of the fields whose values differ
+// between original and updated.
+func changedFields(original map[string]interface{}, updated map[string]interface{}) []string {
+	var fields []string
+	for field, value := range updated {
+		if !reflect.DeepEqual(original[field], value) {
+			fields = append(fields, field)
+		}
+	}
+
+	sort.Strings(fields)
+
+	return fields
+}
+
+// nonNilStrings ensures an empty list is sent as [] rather than null
+// and compares equal to other empty lists.
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -1,7 +1,8 @@
 package pivnet
 
 import (
-	"crypto/tls"
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -13,7 +14,10 @@ import (
 	"time"
 
 	"github.com/pivotal-cf/go-pivnet/download"
+	"github.com/pivotal-cf/go-pivnet/journal"
 	"github.com/pivotal-cf/go-pivnet/logger"
+	"github.com/pivotal-cf/go-pivnet/metrics"
+	"github.com/pivotal-cf/go-pivnet/tracing"
 )
 
 const (
@@ -23,66 +27,126 @@ const (
 )
 
 type Client struct {
-	baseURL   string
-	token     string
-	userAgent string
-	logger    logger.Logger
+	baseURL         string
+	token           string
+	userAgent       string
+	dryRun          bool
+	verifyChecksums bool
+	logger          logger.Logger
+	metrics         metrics.Observer
+	tracer          tracing.Tracer
+
+	journal      journal.Sink
+	journalActor string
 
 	HTTP *http.Client
 
 	downloader download.Client
 
-	Auth                  *AuthService
-	EULA                  *EULAsService
-	ProductFiles          *ProductFilesService
-	FileGroups            *FileGroupsService
-	Releases              *ReleasesService
-	Products              *ProductsService
-	UserGroups            *UserGroupsService
-	ReleaseTypes          *ReleaseTypesService
-	ReleaseDependencies   *ReleaseDependenciesService
-	DependencySpecifiers  *DependencySpecifiersService
-	ReleaseUpgradePaths   *ReleaseUpgradePathsService
-	UpgradePathSpecifiers *UpgradePathSpecifiersService
+	Auth                  AuthAPI
+	EULA                  EULAsAPI
+	ProductFiles          ProductFilesAPI
+	FileGroups            FileGroupsAPI
+	Releases              ReleasesAPI
+	Products              ProductsAPI
+	UserGroups            UserGroupsAPI
+	ReleaseTypes          ReleaseTypesAPI
+	ReleaseDependencies   ReleaseDependenciesAPI
+	DependencySpecifiers  DependencySpecifiersAPI
+	ReleaseUpgradePaths   ReleaseUpgradePathsAPI
+	UpgradePathSpecifiers UpgradePathSpecifiersAPI
 }
 
 type ClientConfig struct {
-	Host              string
+	Host string
+
+	// Hosts lists hosts to fail over between, replacing Host. Reads go to
+	// the first healthy host, and writes always go to the first host.
+	Hosts    []string
+	Failover FailoverConfig
+
 	Token             string
 	UserAgent         string
 	SkipSSLValidation bool
+
+	// DryRun logs mutating requests instead of sending them
+	// and returns a synthesized response.
+	DryRun bool
+
+	// TLS and Proxy configure the default transport.
+	TLS   TLSConfig
+	Proxy ProxyConfig
+
+	// Transport sends API requests and product file downloads. It
+	// defaults to a transport honouring SkipSSLValidation, TLS and Proxy,
+	// which are ignored when it is set.
+	Transport http.RoundTripper
+
+	// Middleware wraps Transport for both API requests and downloads.
+	Middleware []Middleware
+
+	// Metrics is notified of API requests and download progress.
+	Metrics metrics.Observer
+
+	// VerifyChecksums makes DownloadForRelease read back the downloaded
+	// file and return ErrChecksumMismatch if it does not match the SHA256
+	// of the product file. The file must be opened for reading.
+	VerifyChecksums bool
+
+	// Tracer creates spans for service calls, API requests and download
+	// ranges, and propagates trace context to outgoing requests.
+	Tracer tracing.Tracer
+
+	// Journal records every mutating request and its outcome. Requests
+	// skipped by DryRun are not recorded.
+	Journal journal.Sink
+
+	// JournalActor identifies the user in journal records. It defaults to
+	// a fingerprint of Token.
+	JournalActor string
 }
 
 func NewClient(
 	config ClientConfig,
 	logger logger.Logger,
 ) Client {
-	baseURL := fmt.Sprintf("%s%s", config.Host, apiVersion)
+	host := config.Host
+	if len(config.Hosts) > 0 {
+		host = config.Hosts[0]
+	}
+
+	baseURL := fmt.Sprintf("%s%s", strings.TrimSuffix(host, "/"), apiVersion)
+
+	transport := config.transport()
 
 	httpClient := &http.Client{
-		Timeout: 60 * time.Second,
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: config.SkipSSLValidation,
-			},
-			Proxy: http.ProxyFromEnvironment,
-		},
+		Timeout:   60 * time.Second,
+		Transport: transport,
 	}
 
 	ranger := download.NewRanger(concurrentDownloads)
 	downloader := download.Client{
-		HTTPClient: http.DefaultClient,
+		HTTPClient: &http.Client{Transport: transport},
 		Ranger:     ranger,
 		Logger:     logger,
+		Metrics:    config.Metrics,
+		Tracer:     config.Tracer,
 	}
 
 	client := Client{
-		baseURL:    baseURL,
-		token:      config.Token,
-		userAgent:  config.UserAgent,
-		logger:     logger,
-		downloader: downloader,
-		HTTP:       httpClient,
+		baseURL:         baseURL,
+		token:           config.Token,
+		userAgent:       config.UserAgent,
+		dryRun:          config.DryRun,
+		verifyChecksums: config.VerifyChecksums,
+		logger:          logger,
+		metrics:         config.Metrics,
+		tracer:          config.Tracer,
+		downloader:      downloader,
+		HTTP:            httpClient,
+
+		journal:      config.Journal,
+		journalActor: config.JournalActor,
 	}
 
 	client.Auth = &AuthService{client: client}
@@ -113,6 +177,12 @@ func (c Client) CreateRequest(
 
 	endpoint = c.stripHostPrefix(endpoint)
 
+	// Links to further pages may have a query
+	if i := strings.Index(endpoint, "?"); i >= 0 {
+		u.RawQuery = endpoint[i+1:]
+		endpoint = endpoint[:i]
+	}
+
 	u.Path = u.Path + endpoint
 
 	req, err := http.NewRequest(requestType, u.String(), body)
@@ -132,6 +202,32 @@ func (c Client) MakeRequest(
 	endpoint string,
 	expectedStatusCode int,
 	body io.Reader,
+) (*http.Response, error) {
+	return c.MakeRequestWithContext(context.Background(), requestType, endpoint, expectedStatusCode, body)
+}
+
+// MakeRequestWithContext is MakeRequest with a context for cancelling the
+// request and holding the parent of its span.
+func (c Client) MakeRequestWithContext(
+	ctx context.Context,
+	requestType string,
+	endpoint string,
+	expectedStatusCode int,
+	body io.Reader,
+) (*http.Response, error) {
+	if c.dryRun && isMutating(requestType) {
+		return c.dryRunResponse(requestType, endpoint, expectedStatusCode, body)
+	}
+
+	return c.makeRequest(ctx, requestType, endpoint, expectedStatusCode, body)
+}
+
+func (c Client) makeRequest(
+	ctx context.Context,
+	requestType string,
+	endpoint string,
+	expectedStatusCode int,
+	body io.Reader,
 ) (*http.Response, error) {
 	req, err := c.CreateRequest(requestType, endpoint, body)
 	if err != nil {
@@ -145,21 +241,128 @@ func (c Client) MakeRequest(
 
 	c.logger.Debug("Making request", logger.Data{"request": string(reqBytes)})
 
+	ctx, span := c.getTracer().Start(ctx, "HTTP "+requestType,
+		tracing.String(tracing.HTTPMethod, requestType),
+		tracing.String(tracing.HTTPURL, req.URL.String()),
+		tracing.String(tracing.HTTPRoute, endpointTemplate(req)),
+	)
+	defer span.End()
+
+	req = req.WithContext(ctx)
+	c.getTracer().Inject(ctx, req.Header)
+
+	entry := c.startJournal(req)
+
+	start := time.Now()
 	resp, err := c.HTTP.Do(req)
+	c.observeRequest(req, resp, time.Since(start))
 	if err != nil {
+		span.RecordError(err)
+		entry.failed(0, err)
 		return nil, err
 	}
 
+	span.SetAttributes(tracing.Int(tracing.HTTPStatusCode, resp.StatusCode))
+
 	c.logger.Debug("Response status code", logger.Data{"status code": resp.StatusCode})
 	c.logger.Debug("Response headers", logger.Data{"headers": resp.Header})
 
 	if expectedStatusCode > 0 && resp.StatusCode != expectedStatusCode {
-		return nil, c.handleUnexpectedResponse(resp)
+		err := c.handleUnexpectedResponse(resp)
+		span.RecordError(err)
+		entry.failed(resp.StatusCode, err)
+		return nil, err
+	}
+
+	err = entry.succeeded(resp)
+	if err != nil {
+		return nil, err
 	}
 
 	return resp, nil
 }
 
+func (c Client) observeRequest(req *http.Request, resp *http.Response, duration time.Duration) {
+	if c.metrics == nil {
+		return
+	}
+
+	var status int
+	if resp != nil {
+		status = resp.StatusCode
+	}
+
+	c.metrics.Request(req.Method, endpointTemplate(req), status, duration)
+}
+
+func endpointTemplate(req *http.Request) string {
+	path := req.URL.Path
+	if i := strings.Index(path, apiVersion); i >= 0 {
+		path = path[i+len(apiVersion):]
+	}
+
+	return metrics.EndpointTemplate(path)
+}
+
+func isMutating(requestType string) bool {
+	switch requestType {
+	case "GET", "HEAD", "OPTIONS":
+		return false
+	default:
+		return true
+	}
+}
+
+// dryRunResponse echoes the request body back as the response body,
+// so services decode the resource they would have sent.
+func (c Client) dryRunResponse(
+	requestType string,
+	endpoint string,
+	expectedStatusCode int,
+	body io.Reader,
+) (*http.Response, error) {
+	b := []byte("{}")
+	if body != nil {
+		var err error
+		b, err = ioutil.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := c.CreateRequest(requestType, endpoint, bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Info("Dry run - skipping request", logger.Data{
+		"method":   requestType,
+		"endpoint": req.URL.Path,
+		"body":     string(b),
+	})
+
+	statusCode := expectedStatusCode
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+
+	if len(b) == 0 {
+		b = []byte("{}")
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          ioutil.NopCloser(bytes.NewReader(b)),
+		ContentLength: int64(len(b)),
+		Request:       req,
+	}, nil
+}
+
 func (c Client) stripHostPrefix(downloadLink string) string {
 	if strings.HasPrefix(downloadLink, apiVersion) {
 		return downloadLink
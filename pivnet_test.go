@@ -2,7 +2,9 @@ package pivnet_test
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"strings"
 
 	"github.com/onsi/gomega/ghttp"
 	"github.com/pivotal-cf/go-pivnet"
@@ -403,6 +405,83 @@ var _ = Describe("PivnetClient", func() {
 
 	})
 
+	Context("when dry run is enabled", func() {
+		BeforeEach(func() {
+			newClientConfig.DryRun = true
+			client = pivnet.NewClient(newClientConfig, fakeLogger)
+		})
+
+		It("does not send mutating requests", func() {
+			resp, err := client.MakeRequest(
+				"POST",
+				"/foo",
+				http.StatusCreated,
+				strings.NewReader(`{"foo":"bar"}`),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+
+			Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+			b, err := ioutil.ReadAll(resp.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(Equal(`{"foo":"bar"}`))
+		})
+
+		It("logs the request it would have sent", func() {
+			_, err := client.MakeRequest(
+				"DELETE",
+				"/foo",
+				http.StatusNoContent,
+				nil,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			infoLogger := fakeLogger.(*loggerfakes.FakeLogger)
+			Expect(infoLogger.InfoCallCount()).To(Equal(1))
+
+			action, data := infoLogger.InfoArgsForCall(0)
+			Expect(action).To(ContainSubstring("Dry run"))
+			Expect(data).To(Equal([]logger.Data{{
+				"method":   "DELETE",
+				"endpoint": apiPrefix + "/foo",
+				"body":     "{}",
+			}}))
+		})
+
+		It("still sends GET requests", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", fmt.Sprintf("%s/foo", apiPrefix)),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, releases),
+				),
+			)
+
+			_, err := client.MakeRequest(
+				"GET",
+				"/foo",
+				http.StatusOK,
+				nil,
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("returns the resource a service would have created", func() {
+			release, err := client.Releases.Create(pivnet.CreateReleaseConfig{
+				ProductSlug: productSlug,
+				Version:     "1.2.3",
+				ReleaseType: "Minor Release",
+				EULASlug:    "some-eula",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(release.Version).To(Equal("1.2.3"))
+			Expect(release.Availability).To(Equal("Admins Only"))
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+
 	Describe("CreateRequest", func() {
 		It("strips the host prefix if present", func() {
 			req, err := client.CreateRequest(
@@ -0,0 +1,350 @@
+package pivnettest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet"
+	"gopkg.in/yaml.v2"
+)
+
+// Fixture describes the initial state of a server. Resources refer to
+// each other by ID, and resources without an ID are assigned one.
+type Fixture struct {
+	EULAs        []pivnet.EULA      `yaml:"eulas,omitempty" json:"eulas,omitempty"`
+	ReleaseTypes []string           `yaml:"release_types,omitempty" json:"release_types,omitempty"`
+	UserGroups   []pivnet.UserGroup `yaml:"user_groups,omitempty" json:"user_groups,omitempty"`
+	Products     []Product          `yaml:"products,omitempty" json:"products,omitempty"`
+}
+
+type Product struct {
+	ID           int           `yaml:"id,omitempty" json:"id,omitempty"`
+	Slug         string        `yaml:"slug" json:"slug"`
+	Name         string        `yaml:"name,omitempty" json:"name,omitempty"`
+	ProductFiles []ProductFile `yaml:"product_files,omitempty" json:"product_files,omitempty"`
+	FileGroups   []FileGroup   `yaml:"file_groups,omitempty" json:"file_groups,omitempty"`
+	Releases     []Release     `yaml:"releases,omitempty" json:"releases,omitempty"`
+}
+
+// ProductFile is served with Contents when downloaded. If SHA256 is not
+// set it is computed from Contents.
+type ProductFile struct {
+	pivnet.ProductFile `yaml:",inline"`
+	Contents           string `yaml:"contents,omitempty" json:"contents,omitempty"`
+}
+
+type FileGroup struct {
+	ID             int    `yaml:"id,omitempty" json:"id,omitempty"`
+	Name           string `yaml:"name" json:"name"`
+	ProductFileIDs []int  `yaml:"product_file_ids,omitempty" json:"product_file_ids,omitempty"`
+}
+
+// Release lists the resources attached to it by ID. Dependencies and
+// upgrade paths may refer to releases of any product in the fixture.
+type Release struct {
+	pivnet.Release        `yaml:",inline"`
+	EULAAccepted          bool                  `yaml:"eula_accepted,omitempty" json:"eula_accepted,omitempty"`
+	ProductFileIDs        []int                 `yaml:"product_file_ids,omitempty" json:"product_file_ids,omitempty"`
+	FileGroupIDs          []int                 `yaml:"file_group_ids,omitempty" json:"file_group_ids,omitempty"`
+	UserGroupIDs          []int                 `yaml:"user_group_ids,omitempty" json:"user_group_ids,omitempty"`
+	DependencyReleaseIDs  []int                 `yaml:"dependency_release_ids,omitempty" json:"dependency_release_ids,omitempty"`
+	DependencySpecifiers  []DependencySpecifier `yaml:"dependency_specifiers,omitempty" json:"dependency_specifiers,omitempty"`
+	UpgradePathReleaseIDs []int                 `yaml:"upgrade_path_release_ids,omitempty" json:"upgrade_path_release_ids,omitempty"`
+	UpgradePathSpecifiers []string              `yaml:"upgrade_path_specifiers,omitempty" json:"upgrade_path_specifiers,omitempty"`
+}
+
+type DependencySpecifier struct {
+	ProductSlug string `yaml:"product_slug" json:"product_slug"`
+	Specifier   string `yaml:"specifier" json:"specifier"`
+}
+
+// LoadFixture reads a YAML or JSON fixture.
+func LoadFixture(r io.Reader) (Fixture, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return Fixture{}, err
+	}
+
+	var f Fixture
+	err = yaml.Unmarshal(b, &f)
+	if err != nil {
+		return Fixture{}, fmt.Errorf("could not parse fixture: %s", err)
+	}
+
+	return f, nil
+}
+
+func LoadFixtureFile(path string) (Fixture, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Fixture{}, err
+	}
+	defer file.Close()
+
+	return LoadFixture(file)
+}
+
+type state struct {
+	eulas        []pivnet.EULA
+	releaseTypes []pivnet.ReleaseType
+	userGroups   map[int]*pivnet.UserGroup
+	products     map[string]*product
+}
+
+type product struct {
+	pivnet.Product
+	productFiles map[int]*productFile
+	fileGroups   map[int]*fileGroup
+	releases     map[int]*release
+}
+
+type productFile struct {
+	pivnet.ProductFile
+	contents []byte
+}
+
+type fileGroup struct {
+	id           int
+	name         string
+	productFiles []int
+}
+
+type release struct {
+	pivnet.Release
+	productSlug           string
+	eulaAccepted          bool
+	productFiles          []int
+	fileGroups            []int
+	userGroups            []int
+	dependencies          []int
+	dependencySpecifiers  []pivnet.DependencySpecifier
+	upgradePaths          []int
+	upgradePathSpecifiers []pivnet.UpgradePathSpecifier
+}
+
+// Seed adds the resources in f to the server. It may be called more
+// than once, but IDs must not clash with existing resources.
+func (s *Server) Seed(f Fixture) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.reserveFixtureIDs(f)
+
+	s.state.eulas = append(s.state.eulas, f.EULAs...)
+	for i := range s.state.eulas {
+		if s.state.eulas[i].ID == 0 {
+			s.state.eulas[i].ID = s.newID()
+		}
+	}
+
+	for _, releaseType := range f.ReleaseTypes {
+		s.state.releaseTypes = append(s.state.releaseTypes, pivnet.ReleaseType(releaseType))
+	}
+
+	for _, userGroup := range f.UserGroups {
+		userGroup := userGroup
+		if userGroup.ID == 0 {
+			userGroup.ID = s.newID()
+		}
+		if _, ok := s.state.userGroups[userGroup.ID]; ok {
+			return fmt.Errorf("user group %d already exists", userGroup.ID)
+		}
+		s.state.userGroups[userGroup.ID] = &userGroup
+	}
+
+	// Releases are added before their relationships so that dependencies
+	// and upgrade paths can refer to releases of any product
+	type pending struct {
+		product *product
+		release *release
+		fixture Release
+	}
+	var releases []pending
+
+	for _, fp := range f.Products {
+		if _, ok := s.state.products[fp.Slug]; ok {
+			return fmt.Errorf("product '%s' already exists", fp.Slug)
+		}
+
+		p := &product{
+			Product: pivnet.Product{
+				ID:   fp.ID,
+				Slug: fp.Slug,
+				Name: fp.Name,
+			},
+			productFiles: map[int]*productFile{},
+			fileGroups:   map[int]*fileGroup{},
+			releases:     map[int]*release{},
+		}
+		if p.ID == 0 {
+			p.ID = s.newID()
+		}
+		s.state.products[p.Slug] = p
+
+		for _, fpf := range fp.ProductFiles {
+			pf := &productFile{
+				ProductFile: fpf.ProductFile,
+				contents:    []byte(fpf.Contents),
+			}
+			if pf.ID == 0 {
+				pf.ID = s.newID()
+			}
+			if pf.FileTransferStatus == "" {
+				pf.FileTransferStatus = pivnet.FileTransferStatusComplete
+				pf.ReadyToServe = true
+			}
+			if pf.SHA256 == "" && fpf.Contents != "" {
+				sum := sha256.Sum256(pf.contents)
+				pf.SHA256 = hex.EncodeToString(sum[:])
+			}
+			pf.Size = len(pf.contents)
+			p.productFiles[pf.ID] = pf
+		}
+
+		for _, ffg := range fp.FileGroups {
+			fg := &fileGroup{
+				id:   ffg.ID,
+				name: ffg.Name,
+			}
+			if fg.id == 0 {
+				fg.id = s.newID()
+			}
+			for _, id := range ffg.ProductFileIDs {
+				if _, ok := p.productFiles[id]; !ok {
+					return fmt.Errorf("file group '%s' refers to unknown product file %d", fg.name, id)
+				}
+				fg.productFiles = addID(fg.productFiles, id)
+			}
+			p.fileGroups[fg.id] = fg
+		}
+
+		for _, fr := range fp.Releases {
+			r := &release{
+				Release:      fr.Release,
+				productSlug:  p.Slug,
+				eulaAccepted: fr.EULAAccepted,
+			}
+			if r.ID == 0 {
+				r.ID = s.newID()
+			}
+			if _, ok := s.releases[r.ID]; ok {
+				return fmt.Errorf("release %d already exists", r.ID)
+			}
+			if r.EULA != nil {
+				eula, ok := s.eula(r.EULA.Slug)
+				if !ok {
+					return fmt.Errorf("release '%s' refers to unknown EULA '%s'", r.Version, r.EULA.Slug)
+				}
+				r.EULA = &eula
+			}
+			if r.UpdatedAt == "" {
+				r.UpdatedAt = timestamp()
+			}
+
+			p.releases[r.ID] = r
+			s.releases[r.ID] = r
+			releases = append(releases, pending{product: p, release: r, fixture: fr})
+		}
+	}
+
+	for _, pr := range releases {
+		p, r, fr := pr.product, pr.release, pr.fixture
+
+		for _, id := range fr.ProductFileIDs {
+			if _, ok := p.productFiles[id]; !ok {
+				return fmt.Errorf("release '%s' refers to unknown product file %d", r.Version, id)
+			}
+			r.productFiles = addID(r.productFiles, id)
+		}
+
+		for _, id := range fr.FileGroupIDs {
+			if _, ok := p.fileGroups[id]; !ok {
+				return fmt.Errorf("release '%s' refers to unknown file group %d", r.Version, id)
+			}
+			r.fileGroups = addID(r.fileGroups, id)
+		}
+
+		for _, id := range fr.UserGroupIDs {
+			if _, ok := s.state.userGroups[id]; !ok {
+				return fmt.Errorf("release '%s' refers to unknown user group %d", r.Version, id)
+			}
+			r.userGroups = addID(r.userGroups, id)
+		}
+
+		for _, id := range fr.DependencyReleaseIDs {
+			if _, ok := s.releases[id]; !ok {
+				return fmt.Errorf("release '%s' refers to unknown dependency release %d", r.Version, id)
+			}
+			r.dependencies = addID(r.dependencies, id)
+		}
+
+		for _, id := range fr.UpgradePathReleaseIDs {
+			if _, ok := p.releases[id]; !ok {
+				return fmt.Errorf("release '%s' refers to unknown upgrade path release %d", r.Version, id)
+			}
+			r.upgradePaths = addID(r.upgradePaths, id)
+		}
+
+		for _, ds := range fr.DependencySpecifiers {
+			dependency, ok := s.state.products[ds.ProductSlug]
+			if !ok {
+				return fmt.Errorf("release '%s' has a dependency specifier for unknown product '%s'", r.Version, ds.ProductSlug)
+			}
+			r.dependencySpecifiers = append(r.dependencySpecifiers, pivnet.DependencySpecifier{
+				ID:        s.newID(),
+				Product:   dependency.Product,
+				Specifier: ds.Specifier,
+			})
+		}
+
+		for _, specifier := range fr.UpgradePathSpecifiers {
+			r.upgradePathSpecifiers = append(r.upgradePathSpecifiers, pivnet.UpgradePathSpecifier{
+				ID:        s.newID(),
+				Specifier: specifier,
+			})
+		}
+	}
+
+	return nil
+}
+
+// reserveFixtureIDs makes sure generated IDs do not clash with any ID
+// given in the fixture.
+func (s *Server) reserveFixtureIDs(f Fixture) {
+	for _, eula := range f.EULAs {
+		s.reserveID(eula.ID)
+	}
+	for _, userGroup := range f.UserGroups {
+		s.reserveID(userGroup.ID)
+	}
+	for _, p := range f.Products {
+		s.reserveID(p.ID)
+		for _, pf := range p.ProductFiles {
+			s.reserveID(pf.ID)
+		}
+		for _, fg := range p.FileGroups {
+			s.reserveID(fg.ID)
+		}
+		for _, r := range p.Releases {
+			s.reserveID(r.ID)
+		}
+	}
+}
+
+func (s *Server) eula(slug string) (pivnet.EULA, bool) {
+	for _, eula := range s.state.eulas {
+		if eula.Slug == slug {
+			return eula, true
+		}
+	}
+	return pivnet.EULA{}, false
+}
+
+func timestamp() string {
+	return time.Now().UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,1154 @@
+package pivnettest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet"
+)
+
+func (s *Server) apiRoutes() []route {
+	api := func(method string, pattern string, handle func(http.ResponseWriter, *http.Request, params)) route {
+		return route{method: method, api: true, pattern: strings.Split(pattern, "/"), handle: handle}
+	}
+
+	return []route{
+		api("GET", "authentication", s.authentication),
+
+		api("GET", "eulas", s.listEULAs),
+		api("GET", "eulas/:eula", s.getEULA),
+		api("GET", "releases/release_types", s.listReleaseTypes),
+
+		api("GET", "products", s.listProducts),
+		api("GET", "products/:slug", s.getProduct),
+
+		api("GET", "products/:slug/releases", s.listReleases),
+		api("POST", "products/:slug/releases", s.createRelease),
+		api("GET", "products/:slug/releases/:release", s.getRelease),
+		api("PATCH", "products/:slug/releases/:release", s.patchRelease),
+		api("DELETE", "products/:slug/releases/:release", s.deleteRelease),
+		api("POST", "products/:slug/releases/:release/eula_acceptance", s.acceptEULA),
+
+		api("GET", "products/:slug/product_files", s.listProductFiles),
+		api("POST", "products/:slug/product_files", s.createProductFile),
+		api("GET", "products/:slug/product_files/:file", s.getProductFile),
+		api("PATCH", "products/:slug/product_files/:file", s.patchProductFile),
+		api("DELETE", "products/:slug/product_files/:file", s.deleteProductFile),
+		api("GET", "products/:slug/releases/:release/product_files", s.listReleaseProductFiles),
+		api("GET", "products/:slug/releases/:release/product_files/:file", s.getReleaseProductFile),
+		api("POST", "products/:slug/releases/:release/product_files/:file/download", s.downloadLink),
+		api("PATCH", "products/:slug/releases/:release/add_product_file", s.releaseProductFile(addID)),
+		api("PATCH", "products/:slug/releases/:release/remove_product_file", s.releaseProductFile(removeID)),
+
+		api("GET", "products/:slug/file_groups", s.listFileGroups),
+		api("POST", "products/:slug/file_groups", s.createFileGroup),
+		api("GET", "products/:slug/file_groups/:group", s.getFileGroup),
+		api("PATCH", "products/:slug/file_groups/:group", s.patchFileGroup),
+		api("DELETE", "products/:slug/file_groups/:group", s.deleteFileGroup),
+		api("PATCH", "products/:slug/file_groups/:group/add_product_file", s.fileGroupProductFile(addID)),
+		api("PATCH", "products/:slug/file_groups/:group/remove_product_file", s.fileGroupProductFile(removeID)),
+		api("GET", "products/:slug/releases/:release/file_groups", s.listReleaseFileGroups),
+		api("PATCH", "products/:slug/releases/:release/add_file_group", s.releaseFileGroup(addID)),
+		api("PATCH", "products/:slug/releases/:release/remove_file_group", s.releaseFileGroup(removeID)),
+
+		api("GET", "user_groups", s.listUserGroups),
+		api("POST", "user_groups", s.createUserGroup),
+		api("GET", "user_groups/:group", s.getUserGroup),
+		api("PATCH", "user_groups/:group", s.patchUserGroup),
+		api("DELETE", "user_groups/:group", s.deleteUserGroup),
+		api("PATCH", "user_groups/:group/add_member", s.addMember),
+		api("PATCH", "user_groups/:group/remove_member", s.removeMember),
+		api("GET", "products/:slug/releases/:release/user_groups", s.listReleaseUserGroups),
+		api("PATCH", "products/:slug/releases/:release/add_user_group", s.releaseUserGroup(addID)),
+		api("PATCH", "products/:slug/releases/:release/remove_user_group", s.releaseUserGroup(removeID)),
+
+		api("GET", "products/:slug/releases/:release/dependencies", s.listDependencies),
+		api("PATCH", "products/:slug/releases/:release/add_dependency", s.releaseDependency(addID)),
+		api("PATCH", "products/:slug/releases/:release/remove_dependency", s.releaseDependency(removeID)),
+
+		api("GET", "products/:slug/releases/:release/dependency_specifiers", s.listDependencySpecifiers),
+		api("POST", "products/:slug/releases/:release/dependency_specifiers", s.createDependencySpecifier),
+		api("GET", "products/:slug/releases/:release/dependency_specifiers/:specifier", s.getDependencySpecifier),
+		api("DELETE", "products/:slug/releases/:release/dependency_specifiers/:specifier", s.deleteDependencySpecifier),
+
+		api("GET", "products/:slug/releases/:release/upgrade_paths", s.listUpgradePaths),
+		api("PATCH", "products/:slug/releases/:release/add_upgrade_path", s.releaseUpgradePath(addID)),
+		api("PATCH", "products/:slug/releases/:release/remove_upgrade_path", s.releaseUpgradePath(removeID)),
+
+		api("GET", "products/:slug/releases/:release/upgrade_path_specifiers", s.listUpgradePathSpecifiers),
+		api("POST", "products/:slug/releases/:release/upgrade_path_specifiers", s.createUpgradePathSpecifier),
+		api("GET", "products/:slug/releases/:release/upgrade_path_specifiers/:specifier", s.getUpgradePathSpecifier),
+		api("DELETE", "products/:slug/releases/:release/upgrade_path_specifiers/:specifier", s.deleteUpgradePathSpecifier),
+
+		{method: "GET", pattern: []string{"redirect", ":slug", ":file"}, handle: s.redirectDownload},
+		{method: "GET", pattern: []string{"files", ":slug", ":file"}, handle: s.serveFile},
+	}
+}
+
+func (s *Server) authentication(w http.ResponseWriter, r *http.Request, p params) {
+	writeJSON(w, http.StatusOK, map[string]string{})
+}
+
+func (s *Server) listEULAs(w http.ResponseWriter, r *http.Request, p params) {
+	eulas := s.state.eulas
+	if eulas == nil {
+		eulas = []pivnet.EULA{}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"eulas": eulas})
+}
+
+func (s *Server) getEULA(w http.ResponseWriter, r *http.Request, p params) {
+	eula, ok := s.eula(p["eula"])
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("EULA '%s' not found", p["eula"]))
+		return
+	}
+	writeJSON(w, http.StatusOK, eula)
+}
+
+func (s *Server) listReleaseTypes(w http.ResponseWriter, r *http.Request, p params) {
+	releaseTypes := s.state.releaseTypes
+	if releaseTypes == nil {
+		releaseTypes = []pivnet.ReleaseType{}
+	}
+	writeJSON(w, http.StatusOK, pivnet.ReleaseTypesResponse{ReleaseTypes: releaseTypes})
+}
+
+func (s *Server) listProducts(w http.ResponseWriter, r *http.Request, p params) {
+	products := []pivnet.Product{}
+	for _, product := range s.state.products {
+		products = append(products, product.Product)
+	}
+	sort.Slice(products, func(i, j int) bool { return products[i].ID < products[j].ID })
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"products": products})
+}
+
+func (s *Server) getProduct(w http.ResponseWriter, r *http.Request, p params) {
+	product, ok := s.product(w, p)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, product.Product)
+}
+
+func (s *Server) listReleases(w http.ResponseWriter, r *http.Request, p params) {
+	product, ok := s.product(w, p)
+	if !ok {
+		return
+	}
+
+	releases := []pivnet.Release{}
+	for _, release := range product.releases {
+		releases = append(releases, release.Release)
+	}
+	sort.Slice(releases, func(i, j int) bool { return releases[i].ID > releases[j].ID })
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"releases": releases})
+}
+
+func (s *Server) createRelease(w http.ResponseWriter, r *http.Request, p params) {
+	product, ok := s.product(w, p)
+	if !ok {
+		return
+	}
+
+	var body pivnet.CreateReleaseResponse
+	if !decode(w, r, &body) {
+		return
+	}
+
+	rel := &release{Release: body.Release, productSlug: product.Slug}
+	rel.ID = s.newID()
+	if rel.Availability == "" {
+		rel.Availability = "Admins Only"
+	}
+
+	if !s.validateRelease(w, product, rel) {
+		return
+	}
+
+	rel.UpdatedAt = timestamp()
+	product.releases[rel.ID] = rel
+	s.releases[rel.ID] = rel
+
+	writeJSON(w, http.StatusCreated, pivnet.CreateReleaseResponse{Release: rel.Release})
+}
+
+func (s *Server) getRelease(w http.ResponseWriter, r *http.Request, p params) {
+	_, release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, release.Release)
+}
+
+func (s *Server) patchRelease(w http.ResponseWriter, r *http.Request, p params) {
+	product, rel, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		Release json.RawMessage `json:"release"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	var updated pivnet.Release
+	if err := overlay(rel.Release, body.Release, &updated); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid release: %s", err))
+		return
+	}
+	updated.ID = rel.ID
+
+	candidate := *rel
+	candidate.Release = updated
+	if !s.validateRelease(w, product, &candidate) {
+		return
+	}
+
+	rel.Release = candidate.Release
+	rel.UpdatedAt = timestamp()
+
+	writeJSON(w, http.StatusOK, pivnet.CreateReleaseResponse{Release: rel.Release})
+}
+
+// validateRelease checks the fields the API rejects, and resolves the
+// EULA slug to the full EULA.
+func (s *Server) validateRelease(w http.ResponseWriter, product *product, rel *release) bool {
+	var errors []string
+
+	if rel.Version == "" {
+		errors = append(errors, "version can't be blank")
+	}
+	for _, existing := range product.releases {
+		if existing.ID != rel.ID && existing.Version == rel.Version {
+			errors = append(errors, fmt.Sprintf("version '%s' has already been taken", rel.Version))
+		}
+	}
+
+	if rel.ReleaseType != "" && len(s.state.releaseTypes) > 0 {
+		known := false
+		for _, releaseType := range s.state.releaseTypes {
+			known = known || releaseType == rel.ReleaseType
+		}
+		if !known {
+			errors = append(errors, fmt.Sprintf("release type '%s' is not valid", rel.ReleaseType))
+		}
+	}
+
+	if rel.EULA != nil && rel.EULA.Slug != "" {
+		eula, ok := s.eula(rel.EULA.Slug)
+		if ok {
+			rel.EULA = &eula
+		} else {
+			errors = append(errors, fmt.Sprintf("EULA '%s' not found", rel.EULA.Slug))
+		}
+	}
+
+	if len(errors) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, "release is invalid", errors...)
+		return false
+	}
+
+	return true
+}
+
+func (s *Server) deleteRelease(w http.ResponseWriter, r *http.Request, p params) {
+	product, release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	delete(product.releases, release.ID)
+	delete(s.releases, release.ID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) acceptEULA(w http.ResponseWriter, r *http.Request, p params) {
+	_, release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	release.eulaAccepted = true
+
+	writeJSON(w, http.StatusOK, pivnet.EULAAcceptanceResponse{AcceptedAt: timestamp()})
+}
+
+func (s *Server) listProductFiles(w http.ResponseWriter, r *http.Request, p params) {
+	product, ok := s.product(w, p)
+	if !ok {
+		return
+	}
+
+	var ids []int
+	for id := range product.productFiles {
+		ids = append(ids, id)
+	}
+
+	writeJSON(w, http.StatusOK, pivnet.ProductFilesResponse{
+		ProductFiles: s.productFiles(product, 0, sortedIDs(ids)),
+	})
+}
+
+func (s *Server) createProductFile(w http.ResponseWriter, r *http.Request, p params) {
+	product, ok := s.product(w, p)
+	if !ok {
+		return
+	}
+
+	var body pivnet.ProductFileResponse
+	if !decode(w, r, &body) {
+		return
+	}
+
+	if body.ProductFile.AWSObjectKey == "" {
+		writeError(w, http.StatusUnprocessableEntity, "product file is invalid", "aws_object_key can't be blank")
+		return
+	}
+
+	pf := &productFile{ProductFile: body.ProductFile}
+	pf.ID = s.newID()
+	pf.FileTransferStatus = pivnet.FileTransferStatusComplete
+	pf.ReadyToServe = true
+	product.productFiles[pf.ID] = pf
+
+	writeJSON(w, http.StatusCreated, pivnet.ProductFileResponse{ProductFile: pf.ProductFile})
+}
+
+func (s *Server) getProductFile(w http.ResponseWriter, r *http.Request, p params) {
+	_, pf, ok := s.productFile(w, p)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, pivnet.ProductFileResponse{ProductFile: pf.ProductFile})
+}
+
+func (s *Server) patchProductFile(w http.ResponseWriter, r *http.Request, p params) {
+	_, pf, ok := s.productFile(w, p)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		ProductFile json.RawMessage `json:"product_file"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	var updated pivnet.ProductFile
+	if err := overlay(pf.ProductFile, body.ProductFile, &updated); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid product file: %s", err))
+		return
+	}
+	updated.ID = pf.ID
+	pf.ProductFile = updated
+
+	writeJSON(w, http.StatusOK, pivnet.ProductFileResponse{ProductFile: pf.ProductFile})
+}
+
+func (s *Server) deleteProductFile(w http.ResponseWriter, r *http.Request, p params) {
+	product, pf, ok := s.productFile(w, p)
+	if !ok {
+		return
+	}
+
+	delete(product.productFiles, pf.ID)
+	for _, release := range product.releases {
+		release.productFiles = removeID(release.productFiles, pf.ID)
+	}
+	for _, group := range product.fileGroups {
+		group.productFiles = removeID(group.productFiles, pf.ID)
+	}
+
+	writeJSON(w, http.StatusOK, pivnet.ProductFileResponse{ProductFile: pf.ProductFile})
+}
+
+func (s *Server) listReleaseProductFiles(w http.ResponseWriter, r *http.Request, p params) {
+	product, release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, pivnet.ProductFilesResponse{
+		ProductFiles: s.productFiles(product, release.ID, release.productFiles),
+	})
+}
+
+func (s *Server) getReleaseProductFile(w http.ResponseWriter, r *http.Request, p params) {
+	product, release, pf, ok := s.releaseProductFileFor(w, p)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, pivnet.ProductFileResponse{
+		ProductFile: s.productFiles(product, release.ID, []int{pf.ID})[0],
+	})
+}
+
+// downloadLink redirects to a one-off link, as the API does, which in
+// turn redirects to the contents.
+func (s *Server) downloadLink(w http.ResponseWriter, r *http.Request, p params) {
+	product, release, pf, ok := s.releaseProductFileFor(w, p)
+	if !ok {
+		return
+	}
+
+	if release.EULA != nil && !release.eulaAccepted {
+		writeError(w, http.StatusUnavailableForLegalReasons,
+			fmt.Sprintf("the EULA for release '%s' has not been accepted", release.Version))
+		return
+	}
+
+	w.Header().Set("Location", fmt.Sprintf("%s/redirect/%s/%d", s.URL(), product.Slug, pf.ID))
+	w.WriteHeader(http.StatusFound)
+}
+
+func (s *Server) redirectDownload(w http.ResponseWriter, r *http.Request, p params) {
+	http.Redirect(w, r, fmt.Sprintf("/files/%s/%s", p["slug"], p["file"]), http.StatusFound)
+}
+
+// serveFile supports HEAD and byte range requests.
+func (s *Server) serveFile(w http.ResponseWriter, r *http.Request, p params) {
+	_, pf, ok := s.productFile(w, p)
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	http.ServeContent(w, r, pf.AWSObjectKey, time.Time{}, bytes.NewReader(pf.contents))
+}
+
+func (s *Server) releaseProductFile(change func([]int, int) []int) func(http.ResponseWriter, *http.Request, params) {
+	return func(w http.ResponseWriter, r *http.Request, p params) {
+		product, release, ok := s.release(w, p)
+		if !ok {
+			return
+		}
+
+		var body pivnet.ProductFileResponse
+		if !decode(w, r, &body) {
+			return
+		}
+
+		if _, ok := product.productFiles[body.ProductFile.ID]; !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("product file %d not found", body.ProductFile.ID))
+			return
+		}
+
+		release.productFiles = change(release.productFiles, body.ProductFile.ID)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) listFileGroups(w http.ResponseWriter, r *http.Request, p params) {
+	product, ok := s.product(w, p)
+	if !ok {
+		return
+	}
+
+	var ids []int
+	for id := range product.fileGroups {
+		ids = append(ids, id)
+	}
+
+	writeJSON(w, http.StatusOK, pivnet.FileGroupsResponse{FileGroups: s.fileGroups(product, sortedIDs(ids))})
+}
+
+func (s *Server) createFileGroup(w http.ResponseWriter, r *http.Request, p params) {
+	product, ok := s.product(w, p)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		FileGroup pivnet.FileGroup `json:"file_group"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	group := &fileGroup{id: s.newID(), name: body.FileGroup.Name}
+	product.fileGroups[group.id] = group
+
+	writeJSON(w, http.StatusCreated, s.fileGroups(product, []int{group.id})[0])
+}
+
+func (s *Server) getFileGroup(w http.ResponseWriter, r *http.Request, p params) {
+	product, group, ok := s.fileGroup(w, p)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.fileGroups(product, []int{group.id})[0])
+}
+
+func (s *Server) patchFileGroup(w http.ResponseWriter, r *http.Request, p params) {
+	product, group, ok := s.fileGroup(w, p)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		FileGroup pivnet.FileGroup `json:"file_group"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	if body.FileGroup.Name != "" {
+		group.name = body.FileGroup.Name
+	}
+
+	writeJSON(w, http.StatusOK, s.fileGroups(product, []int{group.id})[0])
+}
+
+func (s *Server) deleteFileGroup(w http.ResponseWriter, r *http.Request, p params) {
+	product, group, ok := s.fileGroup(w, p)
+	if !ok {
+		return
+	}
+
+	deleted := s.fileGroups(product, []int{group.id})[0]
+
+	delete(product.fileGroups, group.id)
+	for _, release := range product.releases {
+		release.fileGroups = removeID(release.fileGroups, group.id)
+	}
+
+	writeJSON(w, http.StatusOK, deleted)
+}
+
+func (s *Server) fileGroupProductFile(change func([]int, int) []int) func(http.ResponseWriter, *http.Request, params) {
+	return func(w http.ResponseWriter, r *http.Request, p params) {
+		product, group, ok := s.fileGroup(w, p)
+		if !ok {
+			return
+		}
+
+		var body pivnet.ProductFileResponse
+		if !decode(w, r, &body) {
+			return
+		}
+
+		if _, ok := product.productFiles[body.ProductFile.ID]; !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("product file %d not found", body.ProductFile.ID))
+			return
+		}
+
+		group.productFiles = change(group.productFiles, body.ProductFile.ID)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) listReleaseFileGroups(w http.ResponseWriter, r *http.Request, p params) {
+	product, release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, pivnet.FileGroupsResponse{FileGroups: s.fileGroups(product, release.fileGroups)})
+}
+
+func (s *Server) releaseFileGroup(change func([]int, int) []int) func(http.ResponseWriter, *http.Request, params) {
+	return func(w http.ResponseWriter, r *http.Request, p params) {
+		product, release, ok := s.release(w, p)
+		if !ok {
+			return
+		}
+
+		var body struct {
+			FileGroup pivnet.FileGroup `json:"file_group"`
+		}
+		if !decode(w, r, &body) {
+			return
+		}
+
+		if _, ok := product.fileGroups[body.FileGroup.ID]; !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("file group %d not found", body.FileGroup.ID))
+			return
+		}
+
+		release.fileGroups = change(release.fileGroups, body.FileGroup.ID)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) listUserGroups(w http.ResponseWriter, r *http.Request, p params) {
+	var ids []int
+	for id := range s.state.userGroups {
+		ids = append(ids, id)
+	}
+
+	writeJSON(w, http.StatusOK, pivnet.UserGroupsResponse{UserGroups: s.userGroups(sortedIDs(ids))})
+}
+
+func (s *Server) createUserGroup(w http.ResponseWriter, r *http.Request, p params) {
+	var body struct {
+		UserGroup pivnet.UserGroup `json:"user_group"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	if body.UserGroup.Name == "" {
+		writeError(w, http.StatusUnprocessableEntity, "user group is invalid", "name can't be blank")
+		return
+	}
+
+	group := body.UserGroup
+	group.ID = s.newID()
+	s.state.userGroups[group.ID] = &group
+
+	writeJSON(w, http.StatusCreated, group)
+}
+
+func (s *Server) getUserGroup(w http.ResponseWriter, r *http.Request, p params) {
+	group, ok := s.userGroup(w, p)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, group)
+}
+
+func (s *Server) patchUserGroup(w http.ResponseWriter, r *http.Request, p params) {
+	group, ok := s.userGroup(w, p)
+	if !ok {
+		return
+	}
+
+	var body pivnet.UpdateUserGroupResponse
+	if !decode(w, r, &body) {
+		return
+	}
+
+	if body.UserGroup.Name != "" {
+		group.Name = body.UserGroup.Name
+	}
+	if body.UserGroup.Description != "" {
+		group.Description = body.UserGroup.Description
+	}
+
+	writeJSON(w, http.StatusOK, pivnet.UpdateUserGroupResponse{UserGroup: *group})
+}
+
+func (s *Server) deleteUserGroup(w http.ResponseWriter, r *http.Request, p params) {
+	group, ok := s.userGroup(w, p)
+	if !ok {
+		return
+	}
+
+	delete(s.state.userGroups, group.ID)
+	for _, release := range s.releases {
+		release.userGroups = removeID(release.userGroups, group.ID)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type memberBody struct {
+	Member struct {
+		Email string `json:"email"`
+	} `json:"member"`
+}
+
+func (s *Server) addMember(w http.ResponseWriter, r *http.Request, p params) {
+	group, ok := s.userGroup(w, p)
+	if !ok {
+		return
+	}
+
+	var body memberBody
+	if !decode(w, r, &body) {
+		return
+	}
+
+	found := false
+	for _, member := range group.Members {
+		found = found || member == body.Member.Email
+	}
+	if !found {
+		group.Members = append(group.Members, body.Member.Email)
+	}
+
+	writeJSON(w, http.StatusOK, pivnet.UpdateUserGroupResponse{UserGroup: *group})
+}
+
+func (s *Server) removeMember(w http.ResponseWriter, r *http.Request, p params) {
+	group, ok := s.userGroup(w, p)
+	if !ok {
+		return
+	}
+
+	var body memberBody
+	if !decode(w, r, &body) {
+		return
+	}
+
+	var members []string
+	for _, member := range group.Members {
+		if member != body.Member.Email {
+			members = append(members, member)
+		}
+	}
+	group.Members = members
+
+	writeJSON(w, http.StatusOK, pivnet.UpdateUserGroupResponse{UserGroup: *group})
+}
+
+func (s *Server) listReleaseUserGroups(w http.ResponseWriter, r *http.Request, p params) {
+	_, release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, pivnet.UserGroupsResponse{UserGroups: s.userGroups(release.userGroups)})
+}
+
+func (s *Server) releaseUserGroup(change func([]int, int) []int) func(http.ResponseWriter, *http.Request, params) {
+	return func(w http.ResponseWriter, r *http.Request, p params) {
+		_, release, ok := s.release(w, p)
+		if !ok {
+			return
+		}
+
+		var body struct {
+			UserGroup pivnet.UserGroup `json:"user_group"`
+		}
+		if !decode(w, r, &body) {
+			return
+		}
+
+		if _, ok := s.state.userGroups[body.UserGroup.ID]; !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("user group %d not found", body.UserGroup.ID))
+			return
+		}
+
+		release.userGroups = change(release.userGroups, body.UserGroup.ID)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) listDependencies(w http.ResponseWriter, r *http.Request, p params) {
+	_, release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	dependencies := []pivnet.ReleaseDependency{}
+	for _, id := range release.dependencies {
+		dependency, ok := s.releases[id]
+		if !ok {
+			continue
+		}
+
+		dependencies = append(dependencies, pivnet.ReleaseDependency{
+			Release: pivnet.DependentRelease{
+				ID:      dependency.ID,
+				Version: dependency.Version,
+				Product: s.state.products[dependency.productSlug].Product,
+			},
+		})
+	}
+
+	writeJSON(w, http.StatusOK, pivnet.ReleaseDependenciesResponse{ReleaseDependencies: dependencies})
+}
+
+type releaseIDBody struct {
+	ReleaseID int `json:"release_id"`
+}
+
+func (s *Server) releaseDependency(change func([]int, int) []int) func(http.ResponseWriter, *http.Request, params) {
+	return func(w http.ResponseWriter, r *http.Request, p params) {
+		_, release, ok := s.release(w, p)
+		if !ok {
+			return
+		}
+
+		var body struct {
+			Dependency releaseIDBody `json:"dependency"`
+		}
+		if !decode(w, r, &body) {
+			return
+		}
+
+		if _, ok := s.releases[body.Dependency.ReleaseID]; !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("release %d not found", body.Dependency.ReleaseID))
+			return
+		}
+
+		release.dependencies = change(release.dependencies, body.Dependency.ReleaseID)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) listDependencySpecifiers(w http.ResponseWriter, r *http.Request, p params) {
+	_, release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	specifiers := append([]pivnet.DependencySpecifier{}, release.dependencySpecifiers...)
+	writeJSON(w, http.StatusOK, pivnet.DependencySpecifiersResponse{DependencySpecifiers: specifiers})
+}
+
+func (s *Server) createDependencySpecifier(w http.ResponseWriter, r *http.Request, p params) {
+	_, release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		DependencySpecifier struct {
+			ProductSlug string `json:"product_slug"`
+			Specifier   string `json:"specifier"`
+		} `json:"dependency_specifier"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	dependency, ok := s.state.products[body.DependencySpecifier.ProductSlug]
+	if !ok {
+		writeError(w, http.StatusUnprocessableEntity, "dependency specifier is invalid",
+			fmt.Sprintf("product '%s' not found", body.DependencySpecifier.ProductSlug))
+		return
+	}
+
+	specifier := pivnet.DependencySpecifier{
+		ID:        s.newID(),
+		Product:   dependency.Product,
+		Specifier: body.DependencySpecifier.Specifier,
+	}
+	release.dependencySpecifiers = append(release.dependencySpecifiers, specifier)
+
+	writeJSON(w, http.StatusCreated, pivnet.DependencySpecifierResponse{DependencySpecifier: specifier})
+}
+
+func (s *Server) getDependencySpecifier(w http.ResponseWriter, r *http.Request, p params) {
+	_, release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	for _, specifier := range release.dependencySpecifiers {
+		if specifier.ID == p.int("specifier") {
+			writeJSON(w, http.StatusOK, pivnet.DependencySpecifierResponse{DependencySpecifier: specifier})
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, fmt.Sprintf("dependency specifier %s not found", p["specifier"]))
+}
+
+func (s *Server) deleteDependencySpecifier(w http.ResponseWriter, r *http.Request, p params) {
+	_, release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	for i, specifier := range release.dependencySpecifiers {
+		if specifier.ID == p.int("specifier") {
+			release.dependencySpecifiers = append(release.dependencySpecifiers[:i], release.dependencySpecifiers[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, fmt.Sprintf("dependency specifier %s not found", p["specifier"]))
+}
+
+func (s *Server) listUpgradePaths(w http.ResponseWriter, r *http.Request, p params) {
+	product, release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	upgradePaths := []pivnet.ReleaseUpgradePath{}
+	for _, id := range release.upgradePaths {
+		previous, ok := product.releases[id]
+		if !ok {
+			continue
+		}
+
+		upgradePaths = append(upgradePaths, pivnet.ReleaseUpgradePath{
+			Release: pivnet.UpgradePathRelease{ID: previous.ID, Version: previous.Version},
+		})
+	}
+
+	writeJSON(w, http.StatusOK, pivnet.ReleaseUpgradePathsResponse{ReleaseUpgradePaths: upgradePaths})
+}
+
+func (s *Server) releaseUpgradePath(change func([]int, int) []int) func(http.ResponseWriter, *http.Request, params) {
+	return func(w http.ResponseWriter, r *http.Request, p params) {
+		product, release, ok := s.release(w, p)
+		if !ok {
+			return
+		}
+
+		var body struct {
+			UpgradePath releaseIDBody `json:"upgrade_path"`
+		}
+		if !decode(w, r, &body) {
+			return
+		}
+
+		if _, ok := product.releases[body.UpgradePath.ReleaseID]; !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("release %d not found", body.UpgradePath.ReleaseID))
+			return
+		}
+
+		release.upgradePaths = change(release.upgradePaths, body.UpgradePath.ReleaseID)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) listUpgradePathSpecifiers(w http.ResponseWriter, r *http.Request, p params) {
+	_, release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	specifiers := append([]pivnet.UpgradePathSpecifier{}, release.upgradePathSpecifiers...)
+	writeJSON(w, http.StatusOK, pivnet.UpgradePathSpecifiersResponse{UpgradePathSpecifiers: specifiers})
+}
+
+func (s *Server) createUpgradePathSpecifier(w http.ResponseWriter, r *http.Request, p params) {
+	_, release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	var body pivnet.UpgradePathSpecifierResponse
+	if !decode(w, r, &body) {
+		return
+	}
+
+	specifier := pivnet.UpgradePathSpecifier{
+		ID:        s.newID(),
+		Specifier: body.UpgradePathSpecifier.Specifier,
+	}
+	release.upgradePathSpecifiers = append(release.upgradePathSpecifiers, specifier)
+
+	writeJSON(w, http.StatusCreated, pivnet.UpgradePathSpecifierResponse{UpgradePathSpecifier: specifier})
+}
+
+func (s *Server) getUpgradePathSpecifier(w http.ResponseWriter, r *http.Request, p params) {
+	_, release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	for _, specifier := range release.upgradePathSpecifiers {
+		if specifier.ID == p.int("specifier") {
+			writeJSON(w, http.StatusOK, pivnet.UpgradePathSpecifierResponse{UpgradePathSpecifier: specifier})
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, fmt.Sprintf("upgrade path specifier %s not found", p["specifier"]))
+}
+
+func (s *Server) deleteUpgradePathSpecifier(w http.ResponseWriter, r *http.Request, p params) {
+	_, release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	for i, specifier := range release.upgradePathSpecifiers {
+		if specifier.ID == p.int("specifier") {
+			release.upgradePathSpecifiers = append(release.upgradePathSpecifiers[:i], release.upgradePathSpecifiers[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, fmt.Sprintf("upgrade path specifier %s not found", p["specifier"]))
+}
+
+func (s *Server) product(w http.ResponseWriter, p params) (*product, bool) {
+	product, ok := s.state.products[p["slug"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("product '%s' not found", p["slug"]))
+		return nil, false
+	}
+	return product, true
+}
+
+func (s *Server) release(w http.ResponseWriter, p params) (*product, *release, bool) {
+	product, ok := s.product(w, p)
+	if !ok {
+		return nil, nil, false
+	}
+
+	release, ok := product.releases[p.int("release")]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("release %s not found", p["release"]))
+		return nil, nil, false
+	}
+	return product, release, true
+}
+
+func (s *Server) productFile(w http.ResponseWriter, p params) (*product, *productFile, bool) {
+	product, ok := s.product(w, p)
+	if !ok {
+		return nil, nil, false
+	}
+
+	pf, ok := product.productFiles[p.int("file")]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("product file %s not found", p["file"]))
+		return nil, nil, false
+	}
+	return product, pf, true
+}
+
+// releaseProductFileFor finds a product file attached to the release
+// directly or through one of its file groups.
+func (s *Server) releaseProductFileFor(w http.ResponseWriter, p params) (*product, *release, *productFile, bool) {
+	product, release, ok := s.release(w, p)
+	if !ok {
+		return nil, nil, nil, false
+	}
+
+	id := p.int("file")
+	attached := containsID(release.productFiles, id)
+	for _, groupID := range release.fileGroups {
+		if group, ok := product.fileGroups[groupID]; ok {
+			attached = attached || containsID(group.productFiles, id)
+		}
+	}
+
+	pf, ok := product.productFiles[id]
+	if !ok || !attached {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("product file %s not found for release %d", p["file"], release.ID))
+		return nil, nil, nil, false
+	}
+
+	return product, release, pf, true
+}
+
+func (s *Server) fileGroup(w http.ResponseWriter, p params) (*product, *fileGroup, bool) {
+	product, ok := s.product(w, p)
+	if !ok {
+		return nil, nil, false
+	}
+
+	group, ok := product.fileGroups[p.int("group")]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("file group %s not found", p["group"]))
+		return nil, nil, false
+	}
+	return product, group, true
+}
+
+func (s *Server) userGroup(w http.ResponseWriter, p params) (*pivnet.UserGroup, bool) {
+	group, ok := s.state.userGroups[p.int("group")]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("user group %s not found", p["group"]))
+		return nil, false
+	}
+	return group, true
+}
+
+// productFiles presents the product files with the given IDs. Files
+// listed for a release include a download link.
+func (s *Server) productFiles(product *product, releaseID int, ids []int) []pivnet.ProductFile {
+	productFiles := []pivnet.ProductFile{}
+	for _, id := range ids {
+		pf, ok := product.productFiles[id]
+		if !ok {
+			continue
+		}
+
+		presented := pf.ProductFile
+		if releaseID != 0 {
+			presented.Links = &pivnet.Links{
+				Download: map[string]string{
+					"href": fmt.Sprintf(
+						"%s%s/products/%s/releases/%d/product_files/%d/download",
+						s.URL(),
+						apiPrefix,
+						product.Slug,
+						releaseID,
+						pf.ID,
+					),
+				},
+			}
+		}
+		productFiles = append(productFiles, presented)
+	}
+	return productFiles
+}
+
+func (s *Server) fileGroups(product *product, ids []int) []pivnet.FileGroup {
+	fileGroups := []pivnet.FileGroup{}
+	for _, id := range ids {
+		group, ok := product.fileGroups[id]
+		if !ok {
+			continue
+		}
+
+		fileGroups = append(fileGroups, pivnet.FileGroup{
+			ID:   group.id,
+			Name: group.name,
+			Product: pivnet.FileGroupProduct{
+				ID:   product.ID,
+				Name: product.Name,
+			},
+			ProductFiles: s.productFiles(product, 0, group.productFiles),
+		})
+	}
+	return fileGroups
+}
+
+func (s *Server) userGroups(ids []int) []pivnet.UserGroup {
+	userGroups := []pivnet.UserGroup{}
+	for _, id := range ids {
+		if group, ok := s.state.userGroups[id]; ok {
+			userGroups = append(userGroups, *group)
+		}
+	}
+	return userGroups
+}
+
+// overlay applies the fields present in patch to current, decoding the
+// result into updated.
+func overlay(current interface{}, patch json.RawMessage, updated interface{}) error {
+	b, err := json.Marshal(current)
+	if err != nil {
+		return err
+	}
+
+	fields := map[string]interface{}{}
+	err = json.Unmarshal(b, &fields)
+	if err != nil {
+		return err
+	}
+
+	changes := map[string]interface{}{}
+	err = json.Unmarshal(patch, &changes)
+	if err != nil {
+		return err
+	}
+
+	for name, value := range changes {
+		fields[name] = value
+	}
+
+	b, err = json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, updated)
+}
@@ -0,0 +1,227 @@
+// Package pivnettest provides an in-memory Pivotal Network API server
+// for tests that should not depend on the live service.
+//
+// The server keeps state across requests, so resources created through
+// the client can be read back, and it serves product file contents with
+// the same redirect and byte range behaviour as the real download flow.
+package pivnettest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pivotal-cf/go-pivnet"
+)
+
+const apiPrefix = "/api/v2"
+
+type Server struct {
+	server *httptest.Server
+	routes []route
+
+	mutex    sync.Mutex
+	token    string
+	nextID   int
+	state    state
+	releases map[int]*release
+}
+
+// NewServer starts a server with no products. Use Seed to add some.
+func NewServer() *Server {
+	s := &Server{
+		state: state{
+			userGroups: map[int]*pivnet.UserGroup{},
+			products:   map[string]*product{},
+		},
+		releases: map[int]*release{},
+		nextID:   1,
+	}
+
+	s.routes = s.apiRoutes()
+	s.server = httptest.NewServer(s)
+
+	return s
+}
+
+// URL is the host to pass as pivnet.ClientConfig.Host.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// RequireToken makes the server reject requests that do not
+// authenticate with token. By default any token is accepted.
+func (s *Server) RequireToken(token string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.token = token
+}
+
+// ServeHTTP handles the request into a buffer while holding the lock, and
+// writes the response after releasing it, so slow readers of product file
+// contents do not hold up other requests.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	recorder := httptest.NewRecorder()
+
+	s.mutex.Lock()
+	s.serve(recorder, r)
+	s.mutex.Unlock()
+
+	for name, values := range recorder.Header() {
+		w.Header()[name] = values
+	}
+	w.WriteHeader(recorder.Code)
+	w.Write(recorder.Body.Bytes())
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.Path
+	isAPI := strings.HasPrefix(path, apiPrefix+"/")
+	if isAPI {
+		path = strings.TrimPrefix(path, apiPrefix)
+
+		if s.token != "" && r.Header.Get("Authorization") != "Token "+s.token {
+			writeError(w, http.StatusUnauthorized, "invalid API token")
+			return
+		}
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	pathMatched := false
+	for _, rt := range s.routes {
+		p, ok := rt.match(isAPI, segments)
+		if !ok {
+			continue
+		}
+		pathMatched = true
+
+		if rt.method != r.Method && !(rt.method == "GET" && r.Method == "HEAD") {
+			continue
+		}
+
+		rt.handle(w, r, p)
+		return
+	}
+
+	if pathMatched {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("%s not allowed", r.Method))
+		return
+	}
+
+	writeError(w, http.StatusNotFound, fmt.Sprintf("no route for %s", r.URL.Path))
+}
+
+func (s *Server) newID() int {
+	id := s.nextID
+	s.nextID++
+	return id
+}
+
+func (s *Server) reserveID(id int) {
+	if id >= s.nextID {
+		s.nextID = id + 1
+	}
+}
+
+type params map[string]string
+
+func (p params) int(name string) int {
+	n, _ := strconv.Atoi(p[name])
+	return n
+}
+
+type route struct {
+	method  string
+	api     bool
+	pattern []string
+	handle  func(w http.ResponseWriter, r *http.Request, p params)
+}
+
+func (rt route) match(api bool, segments []string) (params, bool) {
+	if rt.api != api || len(rt.pattern) != len(segments) {
+		return nil, false
+	}
+
+	p := params{}
+	for i, part := range rt.pattern {
+		if strings.HasPrefix(part, ":") {
+			p[part[1:]] = segments[i]
+			continue
+		}
+		if part != segments[i] {
+			return nil, false
+		}
+	}
+
+	return p, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if body != nil {
+		json.NewEncoder(w).Encode(body)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string, errors ...string) {
+	if errors == nil {
+		errors = []string{}
+	}
+	writeJSON(w, status, map[string]interface{}{
+		"message": message,
+		"errors":  errors,
+	})
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %s", err))
+		return false
+	}
+	return true
+}
+
+func sortedIDs(ids []int) []int {
+	sorted := append([]int{}, ids...)
+	sort.Ints(sorted)
+	return sorted
+}
+
+func containsID(ids []int, id int) bool {
+	for _, existing := range ids {
+		if existing == id {
+			return true
+		}
+	}
+	return false
+}
+
+func addID(ids []int, id int) []int {
+	if containsID(ids, id) {
+		return ids
+	}
+	return append(ids, id)
+}
+
+func removeID(ids []int, id int) []int {
+	var kept []int
+	for _, existing := range ids {
+		if existing != id {
+			kept = append(kept, existing)
+		}
+	}
+	return kept
+}
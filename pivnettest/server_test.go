@@ -0,0 +1,275 @@
+package pivnettest_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/logger/loggerfakes"
+	"github.com/pivotal-cf/go-pivnet/pivnettest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const fixtureYAML = `
+eulas:
+- id: 1
+  slug: pivotal_beta_eula
+  name: Pivotal Beta EULA
+release_types:
+- Beta Release
+- Major Release
+products:
+- id: 90
+  slug: some-product
+  name: Some Product
+  product_files:
+  - id: 10
+    name: some-file
+    aws_object_key: product-files/some-file.tgz
+    contents: some file contents
+  file_groups:
+  - id: 20
+    name: some-group
+    product_file_ids: [10]
+  releases:
+  - id: 100
+    version: 1.0.0
+    release_type: Major Release
+    eula:
+      slug: pivotal_beta_eula
+    product_file_ids: [10]
+    file_group_ids: [20]
+  - id: 101
+    version: 1.1.0
+    release_type: Major Release
+    upgrade_path_release_ids: [100]
+    dependency_release_ids: [200]
+    dependency_specifiers:
+    - product_slug: stemcells
+      specifier: 3312.*
+- slug: stemcells
+  name: Stemcells
+  releases:
+  - id: 200
+    version: "3312.1"
+`
+
+var _ = Describe("Server", func() {
+	var (
+		server *pivnettest.Server
+		client pivnet.Client
+	)
+
+	BeforeEach(func() {
+		server = pivnettest.NewServer()
+
+		fixture, err := pivnettest.LoadFixture(strings.NewReader(fixtureYAML))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(server.Seed(fixture)).To(Succeed())
+
+		client = pivnet.NewClient(pivnet.ClientConfig{
+			Host:      server.URL(),
+			Token:     "some-token",
+			UserAgent: "go-pivnet/pivnettest",
+		}, &loggerfakes.FakeLogger{})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("seeded state", func() {
+		It("serves products and releases from the fixture", func() {
+			product, err := client.Products.Get("some-product")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(product).To(Equal(pivnet.Product{ID: 90, Slug: "some-product", Name: "Some Product"}))
+
+			releases, err := client.Releases.List("some-product")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(releases).To(HaveLen(2))
+			Expect(releases[0].Version).To(Equal("1.1.0"))
+			Expect(releases[1].EULA.Name).To(Equal("Pivotal Beta EULA"))
+
+			productFiles, err := client.ProductFiles.ListForRelease("some-product", 100)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(productFiles).To(HaveLen(1))
+			Expect(productFiles[0].SHA256).NotTo(BeEmpty())
+			Expect(productFiles[0].Ready()).To(BeTrue())
+
+			fileGroups, err := client.FileGroups.ListForRelease("some-product", 100)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fileGroups).To(HaveLen(1))
+			Expect(fileGroups[0].ProductFiles).To(HaveLen(1))
+		})
+
+		It("serves relationships between releases", func() {
+			upgradePaths, err := client.ReleaseUpgradePaths.Get("some-product", 101)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(upgradePaths).To(HaveLen(1))
+			Expect(upgradePaths[0].Release.Version).To(Equal("1.0.0"))
+
+			dependencies, err := client.ReleaseDependencies.List("some-product", 101)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dependencies).To(HaveLen(1))
+			Expect(dependencies[0].Release.Product.Slug).To(Equal("stemcells"))
+
+			specifiers, err := client.DependencySpecifiers.List("some-product", 101)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(specifiers).To(HaveLen(1))
+			Expect(specifiers[0].Specifier).To(Equal("3312.*"))
+		})
+
+		It("rejects fixtures with unknown references", func() {
+			err := server.Seed(pivnettest.Fixture{
+				Products: []pivnettest.Product{{
+					Slug:     "other-product",
+					Releases: []pivnettest.Release{{ProductFileIDs: []int{999}}},
+				}},
+			})
+			Expect(err).To(MatchError(ContainSubstring("unknown product file 999")))
+		})
+	})
+
+	Describe("release lifecycle", func() {
+		It("creates, updates and deletes releases", func() {
+			release, err := client.Releases.Create(pivnet.CreateReleaseConfig{
+				ProductSlug: "some-product",
+				Version:     "2.0.0",
+				ReleaseType: "Beta Release",
+				EULASlug:    "pivotal_beta_eula",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(release.ID).NotTo(BeZero())
+			Expect(release.Availability).To(Equal("Admins Only"))
+
+			release.Description = "some description"
+			updated, err := client.Releases.Update("some-product", release)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.Description).To(Equal("some description"))
+
+			fetched, err := client.Releases.Get("some-product", release.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fetched.Description).To(Equal("some description"))
+			Expect(fetched.Version).To(Equal("2.0.0"))
+
+			specifier, err := client.UpgradePathSpecifiers.Create("some-product", release.ID, "1.*")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.UpgradePathSpecifiers.Delete("some-product", release.ID, specifier.ID)).To(Succeed())
+
+			Expect(client.Releases.Delete("some-product", release)).To(Succeed())
+
+			_, err = client.Releases.Get("some-product", release.ID)
+			Expect(err).To(BeAssignableToTypeOf(pivnet.ErrNotFound{}))
+		})
+
+		It("rejects invalid releases", func() {
+			_, err := client.Releases.Create(pivnet.CreateReleaseConfig{
+				ProductSlug: "some-product",
+				Version:     "1.0.0",
+				ReleaseType: "Beta Release",
+				EULASlug:    "unknown_eula",
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("has already been taken"))
+			Expect(err.Error()).To(ContainSubstring("EULA 'unknown_eula' not found"))
+		})
+	})
+
+	Describe("downloading product files", func() {
+		var location *os.File
+
+		BeforeEach(func() {
+			var err error
+			location, err = ioutil.TempFile("", "pivnettest")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			location.Close()
+			os.Remove(location.Name())
+		})
+
+		It("requires the EULA to be accepted", func() {
+			err := client.ProductFiles.DownloadForRelease(location, "some-product", 100, 10, GinkgoWriter)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("has not been accepted"))
+		})
+
+		It("serves the file contents once the EULA is accepted", func() {
+			Expect(client.EULA.Accept("some-product", 100)).To(Succeed())
+
+			err := client.ProductFiles.DownloadForRelease(location, "some-product", 100, 10, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			contents, err := ioutil.ReadFile(location.Name())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some file contents"))
+		})
+
+		It("serves byte ranges", func() {
+			req, err := http.NewRequest("GET", server.URL()+"/files/some-product/10", nil)
+			Expect(err).NotTo(HaveOccurred())
+			req.Header.Set("Range", "bytes=5-8")
+
+			resp, err := http.DefaultClient.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+			defer resp.Body.Close()
+
+			Expect(resp.StatusCode).To(Equal(http.StatusPartialContent))
+			body, err := ioutil.ReadAll(resp.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(Equal("file"))
+		})
+
+		It("answers API requests while a download is being read slowly", func() {
+			Expect(server.Seed(pivnettest.Fixture{
+				Products: []pivnettest.Product{{
+					ID:   91,
+					Slug: "big-product",
+					ProductFiles: []pivnettest.ProductFile{{
+						ProductFile: pivnet.ProductFile{ID: 11, AWSObjectKey: "product-files/big-file.tgz"},
+						Contents:    strings.Repeat("x", 32<<20),
+					}},
+				}},
+			})).To(Succeed())
+
+			// The body is not read, so the server blocks writing it
+			resp, err := http.Get(server.URL() + "/files/big-product/11")
+			Expect(err).NotTo(HaveOccurred())
+			defer resp.Body.Close()
+
+			listed := make(chan error, 1)
+			go func() {
+				_, err := client.Products.List()
+				listed <- err
+			}()
+
+			Eventually(listed, "5s").Should(Receive(BeNil()))
+		})
+	})
+
+	Describe("authentication", func() {
+		It("rejects requests with the wrong token", func() {
+			server.RequireToken("other-token")
+
+			ok, err := client.Auth.Check()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeFalse())
+
+			_, err = client.Products.Get("some-product")
+			Expect(err).To(BeAssignableToTypeOf(pivnet.ErrUnauthorized{}))
+		})
+
+		It("accepts requests with the required token", func() {
+			server.RequireToken("some-token")
+
+			ok, err := client.Auth.Check()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeTrue())
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package pivnet
 
 import (
+	"context"
 	"net/http"
 )
 
@@ -14,11 +15,17 @@ func NewProductFileLinkFetcher(downloadLink string, client Client) ProductFileLi
 }
 
 func (p ProductFileLinkFetcher) NewDownloadLink() (string, error) {
+	return p.NewDownloadLinkWithContext(context.Background())
+}
+
+func (p ProductFileLinkFetcher) NewDownloadLinkWithContext(ctx context.Context) (string, error) {
 	p.client.HTTP.CheckRedirect = func(req *http.Request, via []*http.Request) error {
 		return http.ErrUseLastResponse
 	}
 
-	resp, err := p.client.MakeRequest("POST", p.downloadLink, http.StatusFound, nil)
+	// Fetching a download link does not modify anything,
+	// so it is not skipped in dry-run mode, and may use any host.
+	resp, err := p.client.makeRequest(withReadOnly(ctx), "POST", p.downloadLink, http.StatusFound, nil)
 	if err != nil {
 		return "", err
 	}
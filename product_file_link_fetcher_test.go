@@ -58,5 +58,30 @@ var _ = Describe("PivnetClient - ProductFileLinkFetcher", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(link).To(Equal("http://example.com"))
 		})
+
+		Context("when dry run is enabled", func() {
+			BeforeEach(func() {
+				newClientConfig.DryRun = true
+				client = pivnet.NewClient(newClientConfig, fakeLogger)
+			})
+
+			It("still fetches the download link", func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", fmt.Sprintf("%s/test-endpoint", apiPrefix)),
+						ghttp.RespondWith(http.StatusFound, nil,
+							http.Header{
+								"Location": []string{"http://example.com"},
+							},
+						),
+					),
+				)
+
+				linkFetcher := pivnet.NewProductFileLinkFetcher("/test-endpoint", client)
+				link, err := linkFetcher.NewDownloadLink()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(link).To(Equal("http://example.com"))
+			})
+		})
 	})
 })
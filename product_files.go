@@ -2,14 +2,19 @@ package pivnet
 
 import (
 	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"strings"
 
 	"github.com/pivotal-cf/go-pivnet/download"
 	"github.com/pivotal-cf/go-pivnet/logger"
+	"github.com/pivotal-cf/go-pivnet/tracing"
 )
 
 type ProductFilesService struct {
@@ -36,6 +41,12 @@ type ProductFilesResponse struct {
 	ProductFiles []ProductFile `json:"product_files,omitempty"`
 }
 
+// productFilesPage decodes a ProductFilesResponse and the link to its next page.
+type productFilesPage struct {
+	ProductFilesResponse
+	Links *Links `json:"_links,omitempty"`
+}
+
 type ProductFileResponse struct {
 	ProductFile ProductFile `json:"product_file,omitempty"`
 }
@@ -75,57 +86,110 @@ const (
 	FileTypeOpenSourceLicense = "Open Source License"
 )
 
+const (
+	ProductFileFieldAWSObjectKey       = "aws_object_key"
+	ProductFileFieldDescription        = "description"
+	ProductFileFieldDocsURL            = "docs_url"
+	ProductFileFieldFileType           = "file_type"
+	ProductFileFieldFileVersion        = "file_version"
+	ProductFileFieldIncludedFiles      = "included_files"
+	ProductFileFieldSHA256             = "sha256"
+	ProductFileFieldMD5                = "md5"
+	ProductFileFieldName               = "name"
+	ProductFileFieldPlatforms          = "platforms"
+	ProductFileFieldReleasedAt         = "released_at"
+	ProductFileFieldSystemRequirements = "system_requirements"
+)
+
 func (p ProductFilesService) List(productSlug string) ([]ProductFile, error) {
-	url := fmt.Sprintf("/products/%s/product_files", productSlug)
+	return p.ListWithContext(context.Background(), productSlug)
+}
 
-	var response ProductFilesResponse
-	resp, err := p.client.MakeRequest(
-		"GET",
-		url,
-		http.StatusOK,
-		nil,
-	)
-	if err != nil {
-		return []ProductFile{}, err
-	}
-	defer resp.Body.Close()
+func (p ProductFilesService) ListWithContext(ctx context.Context, productSlug string) ([]ProductFile, error) {
+	ctx, span := p.client.startSpan(ctx, "ProductFiles.List", tracing.String(tracing.ProductSlug, productSlug))
+	defer span.End()
 
-	err = json.NewDecoder(resp.Body).Decode(&response)
+	var productFiles []ProductFile
+	err := p.ListIterWithContext(ctx, productSlug, func(page []ProductFile) error {
+		productFiles = append(productFiles, page...)
+		return nil
+	})
 	if err != nil {
 		return []ProductFile{}, err
 	}
 
-	return response.ProductFiles, nil
+	return productFiles, nil
+}
+
+// ListIter calls fn with each page of product files, following next links.
+// An error returned by fn stops the listing and is returned by ListIter.
+func (p ProductFilesService) ListIter(productSlug string, fn func(page []ProductFile) error) error {
+	return p.ListIterWithContext(context.Background(), productSlug, fn)
+}
+
+func (p ProductFilesService) ListIterWithContext(ctx context.Context, productSlug string, fn func(page []ProductFile) error) error {
+	ctx, span := p.client.startSpan(ctx, "ProductFiles.ListIter", tracing.String(tracing.ProductSlug, productSlug))
+	defer span.End()
+
+	url := fmt.Sprintf("/products/%s/product_files", productSlug)
+
+	return p.client.listPages(ctx, url, func(body io.Reader) (*Links, error) {
+		var response productFilesPage
+		err := json.NewDecoder(body).Decode(&response)
+		if err != nil {
+			return nil, err
+		}
+
+		return response.Links, fn(response.ProductFiles)
+	})
 }
 
 func (p ProductFilesService) ListForRelease(productSlug string, releaseID int) ([]ProductFile, error) {
+	return p.ListForReleaseWithContext(context.Background(), productSlug, releaseID)
+}
+
+func (p ProductFilesService) ListForReleaseWithContext(ctx context.Context, productSlug string, releaseID int) ([]ProductFile, error) {
+	ctx, span := p.client.startSpan(ctx, "ProductFiles.ListForRelease",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/product_files",
 		productSlug,
 		releaseID,
 	)
 
-	var response ProductFilesResponse
-	resp, err := p.client.MakeRequest(
-		"GET",
-		url,
-		http.StatusOK,
-		nil,
-	)
-	if err != nil {
-		return []ProductFile{}, err
-	}
-	defer resp.Body.Close()
-
-	err = json.NewDecoder(resp.Body).Decode(&response)
+	var productFiles []ProductFile
+	err := p.client.listPages(ctx, url, func(body io.Reader) (*Links, error) {
+		var response productFilesPage
+		err := json.NewDecoder(body).Decode(&response)
+		if err != nil {
+			return nil, err
+		}
+
+		productFiles = append(productFiles, response.ProductFiles...)
+		return response.Links, nil
+	})
 	if err != nil {
 		return []ProductFile{}, err
 	}
 
-	return response.ProductFiles, nil
+	return productFiles, nil
 }
 
 func (p ProductFilesService) Get(productSlug string, productFileID int) (ProductFile, error) {
+	return p.GetWithContext(context.Background(), productSlug, productFileID)
+}
+
+func (p ProductFilesService) GetWithContext(ctx context.Context, productSlug string, productFileID int) (ProductFile, error) {
+	ctx, span := p.client.startSpan(ctx, "ProductFiles.Get",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ProductFileID, productFileID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/product_files/%d",
 		productSlug,
@@ -133,7 +197,8 @@ func (p ProductFilesService) Get(productSlug string, productFileID int) (Product
 	)
 
 	var response ProductFileResponse
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestWithContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -153,6 +218,17 @@ func (p ProductFilesService) Get(productSlug string, productFileID int) (Product
 }
 
 func (p ProductFilesService) GetForRelease(productSlug string, releaseID int, productFileID int) (ProductFile, error) {
+	return p.GetForReleaseWithContext(context.Background(), productSlug, releaseID, productFileID)
+}
+
+func (p ProductFilesService) GetForReleaseWithContext(ctx context.Context, productSlug string, releaseID int, productFileID int) (ProductFile, error) {
+	ctx, span := p.client.startSpan(ctx, "ProductFiles.GetForRelease",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+		tracing.Int(tracing.ProductFileID, productFileID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/product_files/%d",
 		productSlug,
@@ -161,7 +237,8 @@ func (p ProductFilesService) GetForRelease(productSlug string, releaseID int, pr
 	)
 
 	var response ProductFileResponse
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestWithContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -181,6 +258,15 @@ func (p ProductFilesService) GetForRelease(productSlug string, releaseID int, pr
 }
 
 func (p ProductFilesService) Create(config CreateProductFileConfig) (ProductFile, error) {
+	return p.CreateWithContext(context.Background(), config)
+}
+
+func (p ProductFilesService) CreateWithContext(ctx context.Context, config CreateProductFileConfig) (ProductFile, error) {
+	ctx, span := p.client.startSpan(ctx, "ProductFiles.Create",
+		tracing.String(tracing.ProductSlug, config.ProductSlug),
+	)
+	defer span.End()
+
 	if config.AWSObjectKey == "" {
 		return ProductFile{}, fmt.Errorf("AWS object key must not be empty")
 	}
@@ -212,7 +298,8 @@ func (p ProductFilesService) Create(config CreateProductFileConfig) (ProductFile
 	}
 
 	var response ProductFileResponse
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestWithContext(
+		ctx,
 		"POST",
 		url,
 		http.StatusCreated,
@@ -231,7 +318,121 @@ func (p ProductFilesService) Create(config CreateProductFileConfig) (ProductFile
 	return response.ProductFile, nil
 }
 
+// Validate checks config before it is passed to Create, returning an
+// ErrValidation listing every invalid field.
+func (p ProductFilesService) Validate(config CreateProductFileConfig) error {
+	var v validator
+
+	v.required("ProductSlug", config.ProductSlug)
+	v.required("AWSObjectKey", config.AWSObjectKey)
+	v.required("Name", config.Name)
+	v.required("FileType", config.FileType)
+
+	v.oneOf("FileType", config.FileType, fileTypes)
+	v.date("ReleasedAt", config.ReleasedAt)
+	v.checksum("SHA256", config.SHA256, sha256Regexp, "SHA256")
+	v.checksum("MD5", config.MD5, md5Regexp, "MD5")
+
+	return v.err()
+}
+
+// ApplyTo returns productFile with the fields specified in c overlaid.
+// Empty strings and nil lists are treated as unspecified.
+func (c CreateProductFileConfig) ApplyTo(productFile ProductFile) ProductFile {
+	desired := productFile
+
+	setIfNotEmpty(&desired.Description, c.Description)
+	setIfNotEmpty(&desired.DocsURL, c.DocsURL)
+	setIfNotEmpty(&desired.FileType, c.FileType)
+	setIfNotEmpty(&desired.FileVersion, c.FileVersion)
+	setIfNotEmpty(&desired.SHA256, c.SHA256)
+	setIfNotEmpty(&desired.MD5, c.MD5)
+	setIfNotEmpty(&desired.Name, c.Name)
+	setIfNotEmpty(&desired.ReleasedAt, c.ReleasedAt)
+
+	if c.IncludedFiles != nil {
+		desired.IncludedFiles = c.IncludedFiles
+	}
+	if c.Platforms != nil {
+		desired.Platforms = c.Platforms
+	}
+	if c.SystemRequirements != nil {
+		desired.SystemRequirements = c.SystemRequirements
+	}
+
+	return desired
+}
+
+// Ensure makes sure a product file matching config exists, keyed by
+// AWS object key. An existing product file is patched with any fields of
+// config that differ; empty values in config are treated as unspecified.
+func (p ProductFilesService) Ensure(config CreateProductFileConfig) (ProductFile, EnsureAction, error) {
+	return p.EnsureWithContext(context.Background(), config)
+}
+
+func (p ProductFilesService) EnsureWithContext(ctx context.Context, config CreateProductFileConfig) (ProductFile, EnsureAction, error) {
+	ctx, span := p.client.startSpan(ctx, "ProductFiles.Ensure",
+		tracing.String(tracing.ProductSlug, config.ProductSlug),
+	)
+	defer span.End()
+
+	if config.AWSObjectKey == "" {
+		return ProductFile{}, "", fmt.Errorf("AWS object key must not be empty")
+	}
+
+	productFiles, err := p.ListWithContext(ctx, config.ProductSlug)
+	if err != nil {
+		return ProductFile{}, "", err
+	}
+
+	var existingID int
+	for _, productFile := range productFiles {
+		if productFile.AWSObjectKey == config.AWSObjectKey {
+			existingID = productFile.ID
+			break
+		}
+	}
+
+	if existingID == 0 {
+		productFile, err := p.CreateWithContext(ctx, config)
+		if err != nil {
+			return ProductFile{}, "", err
+		}
+
+		return productFile, EnsureActionCreated, nil
+	}
+
+	existing, err := p.GetWithContext(ctx, config.ProductSlug, existingID)
+	if err != nil {
+		return ProductFile{}, "", err
+	}
+
+	desired := config.ApplyTo(existing)
+
+	fields := ChangedProductFileFields(existing, desired)
+	if len(fields) == 0 {
+		return existing, EnsureActionUnchanged, nil
+	}
+
+	updated, err := p.PatchWithContext(ctx, config.ProductSlug, desired, fields...)
+	if err != nil {
+		return ProductFile{}, "", err
+	}
+
+	return updated, EnsureActionUpdated, nil
+}
+
 func (p ProductFilesService) Update(productSlug string, productFile ProductFile) (ProductFile, error) {
+	return p.UpdateWithContext(context.Background(), productSlug, productFile)
+}
+
+func (p ProductFilesService) UpdateWithContext(ctx context.Context, productSlug string, productFile ProductFile) (ProductFile, error) {
+	ctx, span := p.client.startSpan(ctx, "ProductFiles.Update",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ProductFileID, productFile.ID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf("/products/%s/product_files/%d", productSlug, productFile.ID)
 
 	body := createUpdateProductFileBody{
@@ -252,7 +453,8 @@ func (p ProductFilesService) Update(productSlug string, productFile ProductFile)
 	}
 
 	var response ProductFileResponse
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestWithContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusOK,
@@ -271,11 +473,91 @@ func (p ProductFilesService) Update(productSlug string, productFile ProductFile)
 	return response.ProductFile, nil
 }
 
+// Patch sends only the product file fields named by fields.
+func (p ProductFilesService) Patch(productSlug string, productFile ProductFile, fields ...string) (ProductFile, error) {
+	return p.PatchWithContext(context.Background(), productSlug, productFile, fields...)
+}
+
+func (p ProductFilesService) PatchWithContext(ctx context.Context, productSlug string, productFile ProductFile, fields ...string) (ProductFile, error) {
+	ctx, span := p.client.startSpan(ctx, "ProductFiles.Patch",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ProductFileID, productFile.ID),
+	)
+	defer span.End()
+
+	values, err := maskFields("product file", productFilePatchValues(productFile), fields)
+	if err != nil {
+		return ProductFile{}, err
+	}
+
+	url := fmt.Sprintf("/products/%s/product_files/%d", productSlug, productFile.ID)
+
+	b, err := json.Marshal(patchBody{"product_file": values})
+	if err != nil {
+		// Untested as we cannot force an error because we are marshalling
+		// a known-good body
+		return ProductFile{}, err
+	}
+
+	var response ProductFileResponse
+	resp, err := p.client.MakeRequestWithContext(
+		ctx,
+		"PATCH",
+		url,
+		http.StatusOK,
+		bytes.NewReader(b),
+	)
+	if err != nil {
+		return ProductFile{}, err
+	}
+	defer resp.Body.Close()
+
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	if err != nil {
+		return ProductFile{}, err
+	}
+
+	return response.ProductFile, nil
+}
+
+// ChangedProductFileFields returns the fields that differ between original
+// and updated, suitable for passing to Patch.
+func ChangedProductFileFields(original ProductFile, updated ProductFile) []string {
+	return changedFields(productFilePatchValues(original), productFilePatchValues(updated))
+}
+
+func productFilePatchValues(productFile ProductFile) map[string]interface{} {
+	return map[string]interface{}{
+		ProductFileFieldAWSObjectKey:       productFile.AWSObjectKey,
+		ProductFileFieldDescription:        productFile.Description,
+		ProductFileFieldDocsURL:            productFile.DocsURL,
+		ProductFileFieldFileType:           productFile.FileType,
+		ProductFileFieldFileVersion:        productFile.FileVersion,
+		ProductFileFieldIncludedFiles:      nonNilStrings(productFile.IncludedFiles),
+		ProductFileFieldSHA256:             productFile.SHA256,
+		ProductFileFieldMD5:                productFile.MD5,
+		ProductFileFieldName:               productFile.Name,
+		ProductFileFieldPlatforms:          nonNilStrings(productFile.Platforms),
+		ProductFileFieldReleasedAt:         productFile.ReleasedAt,
+		ProductFileFieldSystemRequirements: nonNilStrings(productFile.SystemRequirements),
+	}
+}
+
 type createUpdateProductFileBody struct {
 	ProductFile ProductFile `json:"product_file"`
 }
 
 func (p ProductFilesService) Delete(productSlug string, id int) (ProductFile, error) {
+	return p.DeleteWithContext(context.Background(), productSlug, id)
+}
+
+func (p ProductFilesService) DeleteWithContext(ctx context.Context, productSlug string, id int) (ProductFile, error) {
+	ctx, span := p.client.startSpan(ctx, "ProductFiles.Delete",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ProductFileID, id),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/product_files/%d",
 		productSlug,
@@ -283,7 +565,8 @@ func (p ProductFilesService) Delete(productSlug string, id int) (ProductFile, er
 	)
 
 	var response ProductFileResponse
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestWithContext(
+		ctx,
 		"DELETE",
 		url,
 		http.StatusOK,
@@ -307,6 +590,22 @@ func (p ProductFilesService) AddToRelease(
 	releaseID int,
 	productFileID int,
 ) error {
+	return p.AddToReleaseWithContext(context.Background(), productSlug, releaseID, productFileID)
+}
+
+func (p ProductFilesService) AddToReleaseWithContext(
+	ctx context.Context,
+	productSlug string,
+	releaseID int,
+	productFileID int,
+) error {
+	ctx, span := p.client.startSpan(ctx, "ProductFiles.AddToRelease",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+		tracing.Int(tracing.ProductFileID, productFileID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/add_product_file",
 		productSlug,
@@ -326,7 +625,8 @@ func (p ProductFilesService) AddToRelease(
 		return err
 	}
 
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestWithContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
@@ -345,6 +645,22 @@ func (p ProductFilesService) RemoveFromRelease(
 	releaseID int,
 	productFileID int,
 ) error {
+	return p.RemoveFromReleaseWithContext(context.Background(), productSlug, releaseID, productFileID)
+}
+
+func (p ProductFilesService) RemoveFromReleaseWithContext(
+	ctx context.Context,
+	productSlug string,
+	releaseID int,
+	productFileID int,
+) error {
+	ctx, span := p.client.startSpan(ctx, "ProductFiles.RemoveFromRelease",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+		tracing.Int(tracing.ProductFileID, productFileID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/remove_product_file",
 		productSlug,
@@ -364,7 +680,8 @@ func (p ProductFilesService) RemoveFromRelease(
 		return err
 	}
 
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestWithContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
@@ -383,6 +700,22 @@ func (p ProductFilesService) AddToFileGroup(
 	fileGroupID int,
 	productFileID int,
 ) error {
+	return p.AddToFileGroupWithContext(context.Background(), productSlug, fileGroupID, productFileID)
+}
+
+func (p ProductFilesService) AddToFileGroupWithContext(
+	ctx context.Context,
+	productSlug string,
+	fileGroupID int,
+	productFileID int,
+) error {
+	ctx, span := p.client.startSpan(ctx, "ProductFiles.AddToFileGroup",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.FileGroupID, fileGroupID),
+		tracing.Int(tracing.ProductFileID, productFileID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/file_groups/%d/add_product_file",
 		productSlug,
@@ -402,7 +735,8 @@ func (p ProductFilesService) AddToFileGroup(
 		return err
 	}
 
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestWithContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
@@ -421,6 +755,22 @@ func (p ProductFilesService) RemoveFromFileGroup(
 	fileGroupID int,
 	productFileID int,
 ) error {
+	return p.RemoveFromFileGroupWithContext(context.Background(), productSlug, fileGroupID, productFileID)
+}
+
+func (p ProductFilesService) RemoveFromFileGroupWithContext(
+	ctx context.Context,
+	productSlug string,
+	fileGroupID int,
+	productFileID int,
+) error {
+	ctx, span := p.client.startSpan(ctx, "ProductFiles.RemoveFromFileGroup",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.FileGroupID, fileGroupID),
+		tracing.Int(tracing.ProductFileID, productFileID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/file_groups/%d/remove_product_file",
 		productSlug,
@@ -440,7 +790,8 @@ func (p ProductFilesService) RemoveFromFileGroup(
 		return err
 	}
 
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestWithContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
@@ -461,7 +812,26 @@ func (p ProductFilesService) DownloadForRelease(
 	productFileID int,
 	progressWriter io.Writer,
 ) error {
-	pf, err := p.GetForRelease(
+	return p.DownloadForReleaseWithContext(context.Background(), location, productSlug, releaseID, productFileID, progressWriter)
+}
+
+func (p ProductFilesService) DownloadForReleaseWithContext(
+	ctx context.Context,
+	location *os.File,
+	productSlug string,
+	releaseID int,
+	productFileID int,
+	progressWriter io.Writer,
+) error {
+	ctx, span := p.client.startSpan(ctx, "ProductFiles.DownloadForRelease",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+		tracing.Int(tracing.ProductFileID, productFileID),
+	)
+	defer span.End()
+
+	pf, err := p.GetForReleaseWithContext(
+		ctx,
 		productSlug,
 		releaseID,
 		productFileID,
@@ -481,7 +851,13 @@ func (p ProductFilesService) DownloadForRelease(
 
 	p.client.downloader.Bar = download.NewBar()
 
-	err = p.client.downloader.Get(
+	synthetic := false
+	p.client.downloader.Synthetic = func() {
+		synthetic = true
+	}
+
+	err = p.client.downloader.GetWithContext(
+		ctx,
 		location,
 		productFileDownloadLinkFetcher,
 		progressWriter,
@@ -490,5 +866,48 @@ func (p ProductFilesService) DownloadForRelease(
 		return err
 	}
 
-	return nil
+	if !p.client.verifyChecksums {
+		return nil
+	}
+
+	if synthetic {
+		p.client.logger.Debug("Skipping checksum of synthetic download", logger.Data{"productFileID": pf.ID})
+		return nil
+	}
+
+	return p.verifyChecksum(location, pf)
+}
+
+// verifyChecksum compares the downloaded file with the SHA256 published
+// for the product file, if there is one.
+func (p ProductFilesService) verifyChecksum(location *os.File, productFile ProductFile) error {
+	if productFile.SHA256 == "" {
+		return nil
+	}
+
+	info, err := location.Stat()
+	if err != nil {
+		return err
+	}
+
+	hash := sha256.New()
+	_, err = io.Copy(hash, io.NewSectionReader(location, 0, info.Size()))
+	if err != nil {
+		return err
+	}
+
+	actual := hex.EncodeToString(hash.Sum(nil))
+	if strings.EqualFold(actual, productFile.SHA256) {
+		return nil
+	}
+
+	if p.client.metrics != nil {
+		p.client.metrics.ChecksumFailure()
+	}
+
+	return ErrChecksumMismatch{
+		ProductFileID: productFile.ID,
+		Expected:      productFile.SHA256,
+		Actual:        actual,
+	}
 }
@@ -1,9 +1,13 @@
 package pivnet_test
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"os"
 	"regexp"
 	"strconv"
 
@@ -11,6 +15,7 @@ import (
 	"github.com/pivotal-cf/go-pivnet"
 	"github.com/pivotal-cf/go-pivnet/logger"
 	"github.com/pivotal-cf/go-pivnet/logger/loggerfakes"
+	"github.com/pivotal-cf/go-pivnet/metrics"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -566,6 +571,163 @@ var _ = Describe("PivnetClient - product files", func() {
 		})
 	})
 
+	Describe("Validate Product File", func() {
+		var (
+			createProductFileConfig pivnet.CreateProductFileConfig
+		)
+
+		BeforeEach(func() {
+			createProductFileConfig = pivnet.CreateProductFileConfig{
+				ProductSlug:  productSlug,
+				AWSObjectKey: "some-aws-object-key",
+				Name:         "some-file-name",
+				FileType:     pivnet.FileTypeSoftware,
+				ReleasedAt:   "2017-01-31",
+				SHA256:       "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+				MD5:          "d41d8cd98f00b204e9800998ecf8427e",
+			}
+		})
+
+		It("returns no error for a valid config", func() {
+			err := client.ProductFiles.Validate(createProductFileConfig)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when several fields are invalid", func() {
+			BeforeEach(func() {
+				createProductFileConfig.AWSObjectKey = ""
+				createProductFileConfig.FileType = "Sofware"
+				createProductFileConfig.ReleasedAt = "yesterday"
+				createProductFileConfig.SHA256 = "not-a-sha"
+				createProductFileConfig.MD5 = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+			})
+
+			It("returns every problem in a single validation error", func() {
+				err := client.ProductFiles.Validate(createProductFileConfig)
+				Expect(err).To(BeAssignableToTypeOf(pivnet.ErrValidation{}))
+
+				Expect(err.(pivnet.ErrValidation).Errors).To(Equal([]pivnet.FieldError{
+					{Field: "AWSObjectKey", Message: "must not be empty"},
+					{Field: "FileType", Message: `'Sofware' is not one of ["Software" "Documentation" "Open Source License"]`},
+					{Field: "ReleasedAt", Message: "'yesterday' is not a valid date, expected format YYYY-MM-DD"},
+					{Field: "SHA256", Message: "'not-a-sha' is not a valid SHA256 checksum"},
+					{Field: "MD5", Message: "'e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855' is not a valid MD5 checksum"},
+				}))
+			})
+		})
+	})
+
+	Describe("Ensure Product File", func() {
+		var (
+			createProductFileConfig pivnet.CreateProductFileConfig
+			productFilesURL         string
+			productFileURL          string
+		)
+
+		BeforeEach(func() {
+			createProductFileConfig = pivnet.CreateProductFileConfig{
+				ProductSlug:  productSlug,
+				AWSObjectKey: "some-aws-object-key",
+				Name:         "some-file-name",
+				FileType:     pivnet.FileTypeSoftware,
+				SHA256:       "some-sha256",
+				Platforms:    []string{"Linux"},
+			}
+
+			productFilesURL = fmt.Sprintf("%s/products/%s/product_files", apiPrefix, productSlug)
+			productFileURL = fmt.Sprintf("%s/products/%s/product_files/%d", apiPrefix, productSlug, 1234)
+		})
+
+		Context("when no product file has the AWS object key", func() {
+			It("creates the product file", func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", productFilesURL),
+						ghttp.RespondWith(http.StatusOK, `{"product_files":[{"id":1,"aws_object_key":"other-key"}]}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", productFilesURL),
+						ghttp.RespondWith(http.StatusCreated, `{"product_file":{"id":1234}}`),
+					),
+				)
+
+				productFile, action, err := client.ProductFiles.Ensure(createProductFileConfig)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(action).To(Equal(pivnet.EnsureActionCreated))
+				Expect(productFile.ID).To(Equal(1234))
+			})
+		})
+
+		Context("when a matching product file already exists", func() {
+			It("returns the existing product file", func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", productFilesURL),
+						ghttp.RespondWith(http.StatusOK, `{"product_files":[{"id":1234,"aws_object_key":"some-aws-object-key"}]}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", productFileURL),
+						ghttp.RespondWith(http.StatusOK, `{"product_file":{
+							"id":1234,
+							"aws_object_key":"some-aws-object-key",
+							"name":"some-file-name",
+							"file_type":"Software",
+							"sha256":"some-sha256",
+							"platforms":["Linux"],
+							"ready_to_serve":true
+						}}`),
+					),
+				)
+
+				productFile, action, err := client.ProductFiles.Ensure(createProductFileConfig)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(action).To(Equal(pivnet.EnsureActionUnchanged))
+				Expect(productFile.ReadyToServe).To(BeTrue())
+			})
+		})
+
+		Context("when an existing product file differs", func() {
+			It("patches only the differing fields", func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", productFilesURL),
+						ghttp.RespondWith(http.StatusOK, `{"product_files":[{"id":1234,"aws_object_key":"some-aws-object-key"}]}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", productFileURL),
+						ghttp.RespondWith(http.StatusOK, `{"product_file":{
+							"id":1234,
+							"aws_object_key":"some-aws-object-key",
+							"name":"some-file-name",
+							"file_type":"Software",
+							"sha256":"old-sha256"
+						}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PATCH", productFileURL),
+						ghttp.VerifyJSON(`{"product_file":{"sha256":"some-sha256","platforms":["Linux"]}}`),
+						ghttp.RespondWith(http.StatusOK, `{"product_file":{"id":1234}}`),
+					),
+				)
+
+				_, action, err := client.ProductFiles.Ensure(createProductFileConfig)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(action).To(Equal(pivnet.EnsureActionUpdated))
+			})
+		})
+
+		Context("when the AWS object key is empty", func() {
+			It("returns an error without making a request", func() {
+				createProductFileConfig.AWSObjectKey = ""
+
+				_, _, err := client.ProductFiles.Ensure(createProductFileConfig)
+				Expect(err).To(HaveOccurred())
+
+				Expect(server.ReceivedRequests()).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("Update Product File", func() {
 		type requestBody struct {
 			ProductFile pivnet.ProductFile `json:"product_file"`
@@ -668,6 +830,104 @@ var _ = Describe("PivnetClient - product files", func() {
 		})
 	})
 
+	Describe("Patch Product File", func() {
+		var (
+			productFile pivnet.ProductFile
+			patchURL    string
+		)
+
+		BeforeEach(func() {
+			productFile = pivnet.ProductFile{
+				ID:                 1234,
+				Description:        "some-description",
+				DocsURL:            "https://example.com/docs",
+				FileType:           pivnet.FileTypeDocumentation,
+				Platforms:          []string{"Linux", "Windows"},
+				SystemRequirements: nil,
+			}
+
+			patchURL = fmt.Sprintf(
+				"%s/products/%s/product_files/%d",
+				apiPrefix,
+				productSlug,
+				productFile.ID,
+			)
+		})
+
+		It("submits only the requested fields", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PATCH", patchURL),
+					ghttp.VerifyJSON(`{"product_file":{
+						"docs_url":"https://example.com/docs",
+						"file_type":"Documentation",
+						"platforms":["Linux","Windows"],
+						"system_requirements":[]
+					}}`),
+					ghttp.RespondWith(http.StatusOK, `{"product_file":{"id":1234}}`),
+				),
+			)
+
+			updatedProductFile, err := client.ProductFiles.Patch(
+				productSlug,
+				productFile,
+				pivnet.ProductFileFieldDocsURL,
+				pivnet.ProductFileFieldFileType,
+				pivnet.ProductFileFieldPlatforms,
+				pivnet.ProductFileFieldSystemRequirements,
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedProductFile.ID).To(Equal(productFile.ID))
+		})
+
+		Context("when an unknown field is provided", func() {
+			It("returns an error without making a request", func() {
+				_, err := client.ProductFiles.Patch(productSlug, productFile, "ready_to_serve")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("unknown product file field: 'ready_to_serve'"))
+
+				Expect(server.ReceivedRequests()).To(BeEmpty())
+			})
+		})
+
+		Context("when the server responds with a non-200 status code", func() {
+			It("returns an error", func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PATCH", patchURL),
+						ghttp.RespondWithJSONEncoded(http.StatusTeapot, pivnetErr{Message: "foo message"}),
+					),
+				)
+
+				_, err := client.ProductFiles.Patch(productSlug, productFile, pivnet.ProductFileFieldName)
+				Expect(err.Error()).To(ContainSubstring("foo message"))
+			})
+		})
+	})
+
+	Describe("ChangedProductFileFields", func() {
+		It("returns the fields that differ, treating nil and empty lists as equal", func() {
+			original := pivnet.ProductFile{
+				ID:        1234,
+				Name:      "some-name",
+				Platforms: nil,
+				SHA256:    "some-sha",
+			}
+			updated := pivnet.ProductFile{
+				ID:        1234,
+				Name:      "some-name",
+				Platforms: []string{},
+				SHA256:    "other-sha",
+				DocsURL:   "https://example.com/docs",
+			}
+
+			Expect(pivnet.ChangedProductFileFields(original, updated)).To(Equal([]string{
+				pivnet.ProductFileFieldDocsURL,
+				pivnet.ProductFileFieldSHA256,
+			}))
+		})
+	})
+
 	Describe("Delete Product File", func() {
 		var (
 			id = 1234
@@ -1106,9 +1366,8 @@ var _ = Describe("PivnetClient - product files", func() {
 			getStatusCode int
 			getResponse   interface{}
 
-			downloadLinkResponseStatusCode   int
-			cloudfrontLinkResponseStatusCode int
-			cloudfrontDownloadPath           string
+			downloadLinkResponseStatusCode int
+			cloudfrontDownloadPath         string
 		)
 
 		BeforeEach(func() {
@@ -1137,7 +1396,6 @@ var _ = Describe("PivnetClient - product files", func() {
 			}
 
 			downloadLinkResponseStatusCode = http.StatusFound
-			cloudfrontLinkResponseStatusCode = http.StatusOK
 			cloudfrontDownloadPath = "/download"
 		})
 
@@ -1230,6 +1488,89 @@ var _ = Describe("PivnetClient - product files", func() {
 			Expect(contents).To(Equal(downloadLinkResponseBody))
 		})
 
+		Context("when the product file has a SHA256", func() {
+			var (
+				collector *metrics.Collector
+				checksum  string
+			)
+
+			BeforeEach(func() {
+				collector = metrics.NewCollector()
+				newClientConfig.Metrics = collector
+				newClientConfig.VerifyChecksums = true
+				client = pivnet.NewClient(newClientConfig, fakeLogger)
+
+				sum := sha256.Sum256(downloadLinkResponseBody)
+				checksum = hex.EncodeToString(sum[:])
+			})
+
+			JustBeforeEach(func() {
+				server.SetHandler(0, ghttp.RespondWithJSONEncoded(http.StatusOK, pivnet.ProductFileResponse{
+					ProductFile: pivnet.ProductFile{
+						ID:     1234,
+						SHA256: checksum,
+						Links: &pivnet.Links{
+							Download: map[string]string{"href": downloadLink},
+						},
+					},
+				}))
+			})
+
+			It("verifies the downloaded file", func() {
+				tmpFile, err := ioutil.TempFile("", "")
+				Expect(err).NotTo(HaveOccurred())
+				defer os.Remove(tmpFile.Name())
+
+				err = client.ProductFiles.DownloadForRelease(tmpFile, productSlug, releaseID, productFileID, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("when the downloaded file does not match", func() {
+				BeforeEach(func() {
+					checksum = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+				})
+
+				It("returns an error and counts a checksum failure", func() {
+					tmpFile, err := ioutil.TempFile("", "")
+					Expect(err).NotTo(HaveOccurred())
+					defer os.Remove(tmpFile.Name())
+
+					err = client.ProductFiles.DownloadForRelease(tmpFile, productSlug, releaseID, productFileID, GinkgoWriter)
+					Expect(err).To(BeAssignableToTypeOf(pivnet.ErrChecksumMismatch{}))
+					Expect(err.(pivnet.ErrChecksumMismatch).ProductFileID).To(Equal(1234))
+
+					output := &bytes.Buffer{}
+					Expect(collector.Write(output)).To(Succeed())
+					Expect(output.String()).To(ContainSubstring("pivnet_download_checksum_failures_total 1\n"))
+				})
+
+				Context("when checksums are not verified", func() {
+					BeforeEach(func() {
+						newClientConfig.VerifyChecksums = false
+						client = pivnet.NewClient(newClientConfig, fakeLogger)
+					})
+
+					It("downloads the file without reading it back", func() {
+						tmpFile, err := ioutil.TempFile("", "")
+						Expect(err).NotTo(HaveOccurred())
+						defer os.Remove(tmpFile.Name())
+						tmpFile.Close()
+
+						writeOnly, err := os.OpenFile(tmpFile.Name(), os.O_WRONLY, 0600)
+						Expect(err).NotTo(HaveOccurred())
+						defer writeOnly.Close()
+
+						err = client.ProductFiles.DownloadForRelease(writeOnly, productSlug, releaseID, productFileID, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						contents, err := ioutil.ReadFile(tmpFile.Name())
+						Expect(err).NotTo(HaveOccurred())
+						Expect(contents).To(Equal(downloadLinkResponseBody))
+					})
+				})
+			})
+		})
+
 		Context("when productFile.DownloadLink() returns an error", func() {
 			BeforeEach(func() {
 				getResponse = pivnet.ProductFileResponse{
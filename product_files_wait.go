@@ -0,0 +1,192 @@
+package pivnet
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet/logger"
+	"github.com/pivotal-cf/go-pivnet/tracing"
+)
+
+// The file_transfer_status values the API reports for a product file.
+const (
+	FileTransferStatusInProgress = "in_progress"
+	FileTransferStatusComplete   = "complete"
+	FileTransferStatusFailed     = "failed"
+)
+
+const (
+	defaultWaitInitialInterval = 2 * time.Second
+	defaultWaitMaxInterval     = 30 * time.Second
+	defaultWaitMultiplier      = 2
+	defaultWaitParallelism     = 4
+)
+
+// WaitConfig controls how often WaitUntilReady polls. Zero values use
+// the defaults.
+type WaitConfig struct {
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	Multiplier      float64
+	Parallelism     int
+}
+
+// Ready reports whether the product file has finished transferring and
+// can be downloaded. Product files without a transfer status are ready
+// once they are ready to serve.
+func (p ProductFile) Ready() bool {
+	return p.ReadyToServe &&
+		(p.FileTransferStatus == "" || p.FileTransferStatus == FileTransferStatusComplete)
+}
+
+// TransferFailed reports whether the product file transfer failed, after
+// which it will not become ready.
+func (p ProductFile) TransferFailed() bool {
+	return p.FileTransferStatus == FileTransferStatusFailed
+}
+
+// WaitUntilReady polls each product file until it is ready, returning
+// them in the order given.
+//
+// It returns ErrFileTransferFailed as soon as any transfer fails, and the
+// context error if ctx is done first.
+func (p ProductFilesService) WaitUntilReady(
+	ctx context.Context,
+	productSlug string,
+	productFileIDs []int,
+	config WaitConfig,
+) ([]ProductFile, error) {
+	ctx, span := p.client.startSpan(ctx, "ProductFiles.WaitUntilReady",
+		tracing.String(tracing.ProductSlug, productSlug),
+	)
+	defer span.End()
+
+	config = config.withDefaults()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		tokens   = make(chan struct{}, config.Parallelism)
+		ready    = make([]ProductFile, len(productFileIDs))
+	)
+
+	for i, productFileID := range productFileIDs {
+		i, productFileID := i, productFileID
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			select {
+			case tokens <- struct{}{}:
+				defer func() { <-tokens }()
+			case <-ctx.Done():
+				return
+			}
+
+			productFile, err := p.waitForFile(ctx, productSlug, productFileID, config)
+			if err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+
+			ready[i] = productFile
+		}()
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	// The parent context may have ended before any file was polled
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return ready, nil
+}
+
+func (p ProductFilesService) waitForFile(
+	ctx context.Context,
+	productSlug string,
+	productFileID int,
+	config WaitConfig,
+) (ProductFile, error) {
+	interval := config.InitialInterval
+
+	for {
+		productFile, err := p.GetWithContext(ctx, productSlug, productFileID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ProductFile{}, errNotReady(ctx, productFileID)
+			}
+			return ProductFile{}, err
+		}
+
+		if productFile.TransferFailed() {
+			return ProductFile{}, ErrFileTransferFailed{
+				ProductFileID: productFile.ID,
+				Name:          productFile.Name,
+				Status:        productFile.FileTransferStatus,
+			}
+		}
+
+		if productFile.Ready() {
+			return productFile, nil
+		}
+
+		p.client.logger.Debug("Waiting for product file", logger.Data{
+			"product_file_id":      productFileID,
+			"file_transfer_status": productFile.FileTransferStatus,
+			"ready_to_serve":       productFile.ReadyToServe,
+			"interval":             interval.String(),
+		})
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ProductFile{}, errNotReady(ctx, productFileID)
+		case <-timer.C:
+		}
+
+		interval = time.Duration(float64(interval) * config.Multiplier)
+		if interval > config.MaxInterval {
+			interval = config.MaxInterval
+		}
+	}
+}
+
+func errNotReady(ctx context.Context, productFileID int) error {
+	return fmt.Errorf("product file %d not ready: %w", productFileID, ctx.Err())
+}
+
+func (c WaitConfig) withDefaults() WaitConfig {
+	if c.InitialInterval <= 0 {
+		c.InitialInterval = defaultWaitInitialInterval
+	}
+	if c.MaxInterval <= 0 {
+		c.MaxInterval = defaultWaitMaxInterval
+	}
+	if c.MaxInterval < c.InitialInterval {
+		c.MaxInterval = c.InitialInterval
+	}
+	if c.Multiplier < 1 {
+		c.Multiplier = defaultWaitMultiplier
+	}
+	if c.Parallelism <= 0 {
+		c.Parallelism = defaultWaitParallelism
+	}
+	return c
+}
@@ -0,0 +1,190 @@
+package pivnet_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/onsi/gomega/ghttp"
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/logger/loggerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PivnetClient - wait for product files", func() {
+	var (
+		server *ghttp.Server
+		client pivnet.Client
+		config pivnet.WaitConfig
+
+		mutex sync.Mutex
+		polls map[int]int
+	)
+
+	productFileURL := func(id int) string {
+		return fmt.Sprintf("%s/products/%s/product_files/%d", apiPrefix, productSlug, id)
+	}
+
+	// routeFile responds with each status in turn, repeating the last one
+	routeFile := func(id int, statuses ...string) {
+		server.RouteToHandler("GET", productFileURL(id), func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			poll := polls[id]
+			polls[id]++
+			mutex.Unlock()
+
+			if poll >= len(statuses) {
+				poll = len(statuses) - 1
+			}
+
+			fmt.Fprintf(w, `{"product_file":{"id":%d,"name":"file-%d",%s}}`, id, id, statuses[poll])
+		})
+	}
+
+	pending := `"file_transfer_status":"in_progress","ready_to_serve":false`
+	complete := `"file_transfer_status":"complete","ready_to_serve":true`
+	failed := `"file_transfer_status":"failed","ready_to_serve":false`
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		client = pivnet.NewClient(pivnet.ClientConfig{
+			Host:      server.URL(),
+			Token:     "my-auth-token",
+			UserAgent: "pivnet-resource/0.1.0 (some-url)",
+		}, &loggerfakes.FakeLogger{})
+
+		config = pivnet.WaitConfig{
+			InitialInterval: time.Millisecond,
+			MaxInterval:     4 * time.Millisecond,
+		}
+		polls = map[int]int{}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("polls until every file is ready", func() {
+		routeFile(1, pending, pending, complete)
+		routeFile(2, complete)
+		routeFile(3, pending, complete)
+
+		productFiles, err := client.ProductFiles.WaitUntilReady(context.Background(), productSlug, []int{1, 2, 3}, config)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(productFiles).To(HaveLen(3))
+		for i, productFile := range productFiles {
+			Expect(productFile.ID).To(Equal(i + 1))
+			Expect(productFile.Ready()).To(BeTrue())
+		}
+
+		Expect(polls).To(Equal(map[int]int{1: 3, 2: 1, 3: 2}))
+	})
+
+	It("fails fast with a typed error when a transfer fails", func() {
+		routeFile(1, pending)
+		routeFile(2, pending, failed)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		start := time.Now()
+		_, err := client.ProductFiles.WaitUntilReady(ctx, productSlug, []int{1, 2}, config)
+		Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+
+		Expect(err).To(Equal(pivnet.ErrFileTransferFailed{
+			ProductFileID: 2,
+			Name:          "file-2",
+			Status:        "failed",
+		}))
+		Expect(err).To(MatchError("transfer of product file 'file-2' (2) failed with status 'failed'"))
+	})
+
+	It("returns the context error when the deadline passes", func() {
+		routeFile(1, pending)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		_, err := client.ProductFiles.WaitUntilReady(ctx, productSlug, []int{1}, config)
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("product file 1 not ready"))
+	})
+
+	It("cancels a request in flight when the deadline passes", func() {
+		server.RouteToHandler("GET", productFileURL(1), func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err := client.ProductFiles.WaitUntilReady(ctx, productSlug, []int{1}, config)
+		Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("product file 1 not ready"))
+	})
+
+	It("keeps polling files with a status it does not know", func() {
+		routeFile(1, `"file_transfer_status":"queued","ready_to_serve":false`, complete)
+
+		productFiles, err := client.ProductFiles.WaitUntilReady(context.Background(), productSlug, []int{1}, config)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(productFiles[0].Ready()).To(BeTrue())
+		Expect(polls[1]).To(Equal(2))
+	})
+
+	It("polls no more files at once than the parallelism allows", func() {
+		var inFlight, maxInFlight int
+		for id := 1; id <= 4; id++ {
+			id := id
+			server.RouteToHandler("GET", productFileURL(id), func(w http.ResponseWriter, r *http.Request) {
+				mutex.Lock()
+				inFlight++
+				if inFlight > maxInFlight {
+					maxInFlight = inFlight
+				}
+				mutex.Unlock()
+
+				time.Sleep(5 * time.Millisecond)
+
+				mutex.Lock()
+				inFlight--
+				mutex.Unlock()
+
+				fmt.Fprintf(w, `{"product_file":{"id":%d,%s}}`, id, complete)
+			})
+		}
+
+		config.Parallelism = 2
+
+		_, err := client.ProductFiles.WaitUntilReady(context.Background(), productSlug, []int{1, 2, 3, 4}, config)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(maxInFlight).To(BeNumerically("<=", 2))
+	})
+
+	Context("when getting a product file fails", func() {
+		BeforeEach(func() {
+			server.RouteToHandler("GET", productFileURL(1),
+				ghttp.RespondWith(http.StatusTeapot, `{"message":"foo message"}`))
+		})
+
+		It("returns the error", func() {
+			_, err := client.ProductFiles.WaitUntilReady(context.Background(), productSlug, []int{1}, config)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("foo message"))
+		})
+	})
+})
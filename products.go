@@ -1,10 +1,13 @@
 package pivnet
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/pivotal-cf/go-pivnet/logger"
+	"github.com/pivotal-cf/go-pivnet/tracing"
 	"encoding/json"
 )
 
@@ -23,34 +26,68 @@ type ProductsResponse struct {
 	Products []Product `json:"products,omitempty"`
 }
 
+// productsPage decodes a ProductsResponse and the link to its next page.
+type productsPage struct {
+	ProductsResponse
+	Links *Links `json:"_links,omitempty"`
+}
+
 func (p ProductsService) List() ([]Product, error) {
-	url := "/products"
+	return p.ListWithContext(context.Background())
+}
 
-	var response ProductsResponse
-	resp, err := p.client.MakeRequest(
-		"GET",
-		url,
-		http.StatusOK,
-		nil,
-	)
-	if err != nil {
-		return []Product{}, err
-	}
-	defer resp.Body.Close()
+func (p ProductsService) ListWithContext(ctx context.Context) ([]Product, error) {
+	ctx, span := p.client.startSpan(ctx, "Products.List")
+	defer span.End()
 
-	err = json.NewDecoder(resp.Body).Decode(&response)
+	var products []Product
+	err := p.ListIterWithContext(ctx, func(page []Product) error {
+		products = append(products, page...)
+		return nil
+	})
 	if err != nil {
 		return []Product{}, err
 	}
 
-	return response.Products, nil
+	return products, nil
+}
+
+// ListIter calls fn with each page of products, following next links.
+// An error returned by fn stops the listing and is returned by ListIter.
+func (p ProductsService) ListIter(fn func(page []Product) error) error {
+	return p.ListIterWithContext(context.Background(), fn)
+}
+
+func (p ProductsService) ListIterWithContext(ctx context.Context, fn func(page []Product) error) error {
+	ctx, span := p.client.startSpan(ctx, "Products.ListIter")
+	defer span.End()
+
+	url := "/products"
+
+	return p.client.listPages(ctx, url, func(body io.Reader) (*Links, error) {
+		var response productsPage
+		err := json.NewDecoder(body).Decode(&response)
+		if err != nil {
+			return nil, err
+		}
+
+		return response.Links, fn(response.Products)
+	})
 }
 
 func (p ProductsService) Get(slug string) (Product, error) {
+	return p.GetWithContext(context.Background(), slug)
+}
+
+func (p ProductsService) GetWithContext(ctx context.Context, slug string) (Product, error) {
+	ctx, span := p.client.startSpan(ctx, "Products.Get", tracing.String(tracing.ProductSlug, slug))
+	defer span.End()
+
 	url := fmt.Sprintf("/products/%s", slug)
 
 	var response Product
-	resp, err := p.client.MakeRequest(
+	resp, err := p.client.MakeRequestWithContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -0,0 +1,79 @@
+package pivnet_test
+
+import (
+	"net/http"
+
+	"github.com/onsi/gomega/ghttp"
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/logger/loggerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PivnetClient - proxy", func() {
+	var (
+		proxy  *ghttp.Server
+		server *ghttp.Server
+	)
+
+	BeforeEach(func() {
+		proxy = ghttp.NewServer()
+		proxy.RouteToHandler("GET", apiPrefix+"/products/some-product", func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.Host).To(Equal("pivnet.example.com"))
+			w.Write([]byte(`{"id":1,"slug":"some-product","name":"proxied"}`))
+		})
+
+		server = ghttp.NewServer()
+		server.RouteToHandler("GET", apiPrefix+"/products/some-product",
+			ghttp.RespondWith(http.StatusOK, `{"id":1,"slug":"some-product","name":"direct"}`))
+	})
+
+	AfterEach(func() {
+		proxy.Close()
+		server.Close()
+	})
+
+	getProduct := func(host string, proxyConfig pivnet.ProxyConfig) (pivnet.Product, error) {
+		client := pivnet.NewClient(pivnet.ClientConfig{
+			Host:  host,
+			Token: "my-auth-token",
+			Proxy: proxyConfig,
+		}, &loggerfakes.FakeLogger{})
+
+		return client.Products.Get("some-product")
+	}
+
+	It("sends requests through the proxy", func() {
+		product, err := getProduct("http://pivnet.example.com", pivnet.ProxyConfig{URL: proxy.URL()})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(product.Name).To(Equal("proxied"))
+	})
+
+	It("connects directly to hosts matching NoProxy", func() {
+		for _, noProxy := range []string{"127.0.0.1", "127.0.0.0/8", "*"} {
+			product, err := getProduct(server.URL(), pivnet.ProxyConfig{
+				URL:     proxy.URL(),
+				NoProxy: []string{"internal.example.com", noProxy},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(product.Name).To(Equal("direct"))
+		}
+
+		Expect(proxy.ReceivedRequests()).To(BeEmpty())
+	})
+
+	It("matches subdomains of NoProxy hosts", func() {
+		_, err := getProduct("http://mirror.example.com", pivnet.ProxyConfig{
+			URL:     proxy.URL(),
+			NoProxy: []string{".example.com"},
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(proxy.ReceivedRequests()).To(BeEmpty())
+	})
+
+	It("returns an error for an invalid proxy URL", func() {
+		_, err := getProduct(server.URL(), pivnet.ProxyConfig{URL: "::not-a-url"})
+		Expect(err).To(MatchError(ContainSubstring("invalid proxy URL")))
+	})
+})
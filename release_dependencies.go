@@ -2,9 +2,12 @@ package pivnet
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+
+	"github.com/pivotal-cf/go-pivnet/tracing"
 )
 
 type ReleaseDependenciesService struct {
@@ -26,6 +29,16 @@ type DependentRelease struct {
 }
 
 func (r ReleaseDependenciesService) List(productSlug string, releaseID int) ([]ReleaseDependency, error) {
+	return r.ListWithContext(context.Background(), productSlug, releaseID)
+}
+
+func (r ReleaseDependenciesService) ListWithContext(ctx context.Context, productSlug string, releaseID int) ([]ReleaseDependency, error) {
+	ctx, span := r.client.startSpan(ctx, "ReleaseDependencies.List",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/dependencies",
 		productSlug,
@@ -33,7 +46,8 @@ func (r ReleaseDependenciesService) List(productSlug string, releaseID int) ([]R
 	)
 
 	var response ReleaseDependenciesResponse
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestWithContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -57,6 +71,21 @@ func (r ReleaseDependenciesService) Add(
 	releaseID int,
 	dependentReleaseID int,
 ) error {
+	return r.AddWithContext(context.Background(), productSlug, releaseID, dependentReleaseID)
+}
+
+func (r ReleaseDependenciesService) AddWithContext(
+	ctx context.Context,
+	productSlug string,
+	releaseID int,
+	dependentReleaseID int,
+) error {
+	ctx, span := r.client.startSpan(ctx, "ReleaseDependencies.Add",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/add_dependency",
 		productSlug,
@@ -76,7 +105,8 @@ func (r ReleaseDependenciesService) Add(
 		return err
 	}
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestWithContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
@@ -95,6 +125,21 @@ func (r ReleaseDependenciesService) Remove(
 	releaseID int,
 	dependentReleaseID int,
 ) error {
+	return r.RemoveWithContext(context.Background(), productSlug, releaseID, dependentReleaseID)
+}
+
+func (r ReleaseDependenciesService) RemoveWithContext(
+	ctx context.Context,
+	productSlug string,
+	releaseID int,
+	dependentReleaseID int,
+) error {
+	ctx, span := r.client.startSpan(ctx, "ReleaseDependencies.Remove",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/remove_dependency",
 		productSlug,
@@ -114,7 +159,8 @@ func (r ReleaseDependenciesService) Remove(
 		return err
 	}
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestWithContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
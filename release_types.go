@@ -1,6 +1,7 @@
 package pivnet
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"encoding/json"
@@ -17,10 +18,18 @@ type ReleaseTypesResponse struct {
 }
 
 func (r ReleaseTypesService) Get() ([]ReleaseType, error) {
+	return r.GetWithContext(context.Background())
+}
+
+func (r ReleaseTypesService) GetWithContext(ctx context.Context) ([]ReleaseType, error) {
+	ctx, span := r.client.startSpan(ctx, "ReleaseTypes.Get")
+	defer span.End()
+
 	url := fmt.Sprintf("/releases/release_types")
 
 	var response ReleaseTypesResponse
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestWithContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
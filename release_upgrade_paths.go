@@ -2,9 +2,12 @@ package pivnet
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+
+	"github.com/pivotal-cf/go-pivnet/tracing"
 )
 
 type ReleaseUpgradePathsService struct {
@@ -25,6 +28,16 @@ type UpgradePathRelease struct {
 }
 
 func (r ReleaseUpgradePathsService) Get(productSlug string, releaseID int) ([]ReleaseUpgradePath, error) {
+	return r.GetWithContext(context.Background(), productSlug, releaseID)
+}
+
+func (r ReleaseUpgradePathsService) GetWithContext(ctx context.Context, productSlug string, releaseID int) ([]ReleaseUpgradePath, error) {
+	ctx, span := r.client.startSpan(ctx, "ReleaseUpgradePaths.Get",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/upgrade_paths",
 		productSlug,
@@ -32,7 +45,8 @@ func (r ReleaseUpgradePathsService) Get(productSlug string, releaseID int) ([]Re
 	)
 
 	var response ReleaseUpgradePathsResponse
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestWithContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -56,6 +70,21 @@ func (r ReleaseUpgradePathsService) Add(
 	releaseID int,
 	previousReleaseID int,
 ) error {
+	return r.AddWithContext(context.Background(), productSlug, releaseID, previousReleaseID)
+}
+
+func (r ReleaseUpgradePathsService) AddWithContext(
+	ctx context.Context,
+	productSlug string,
+	releaseID int,
+	previousReleaseID int,
+) error {
+	ctx, span := r.client.startSpan(ctx, "ReleaseUpgradePaths.Add",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/add_upgrade_path",
 		productSlug,
@@ -75,7 +104,8 @@ func (r ReleaseUpgradePathsService) Add(
 		return err
 	}
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestWithContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
@@ -94,6 +124,21 @@ func (r ReleaseUpgradePathsService) Remove(
 	releaseID int,
 	previousReleaseID int,
 ) error {
+	return r.RemoveWithContext(context.Background(), productSlug, releaseID, previousReleaseID)
+}
+
+func (r ReleaseUpgradePathsService) RemoveWithContext(
+	ctx context.Context,
+	productSlug string,
+	releaseID int,
+	previousReleaseID int,
+) error {
+	ctx, span := r.client.startSpan(ctx, "ReleaseUpgradePaths.Remove",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/remove_upgrade_path",
 		productSlug,
@@ -113,7 +158,8 @@ func (r ReleaseUpgradePathsService) Remove(
 		return err
 	}
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestWithContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
@@ -2,12 +2,15 @@ package pivnet
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
 	"github.com/pivotal-cf/go-pivnet/logger"
+	"github.com/pivotal-cf/go-pivnet/tracing"
 )
 
 type ReleasesService struct {
@@ -23,6 +26,12 @@ type ReleasesResponse struct {
 	Releases []Release `json:"releases,omitempty"`
 }
 
+// releasesPage decodes a ReleasesResponse and the link to its next page.
+type releasesPage struct {
+	ReleasesResponse
+	Links *Links `json:"_links,omitempty"`
+}
+
 type CreateReleaseResponse struct {
 	Release Release `json:"release,omitempty"`
 }
@@ -64,29 +73,81 @@ type CreateReleaseConfig struct {
 	EndOfAvailabilityDate string
 }
 
+const (
+	ReleaseFieldAvailability          = "availability"
+	ReleaseFieldEULA                  = "eula"
+	ReleaseFieldOSSCompliant          = "oss_compliant"
+	ReleaseFieldReleaseDate           = "release_date"
+	ReleaseFieldReleaseType           = "release_type"
+	ReleaseFieldVersion               = "version"
+	ReleaseFieldDescription           = "description"
+	ReleaseFieldReleaseNotesURL       = "release_notes_url"
+	ReleaseFieldControlled            = "controlled"
+	ReleaseFieldECCN                  = "eccn"
+	ReleaseFieldLicenseException      = "license_exception"
+	ReleaseFieldEndOfSupportDate      = "end_of_support_date"
+	ReleaseFieldEndOfGuidanceDate     = "end_of_guidance_date"
+	ReleaseFieldEndOfAvailabilityDate = "end_of_availability_date"
+)
+
 func (r ReleasesService) List(productSlug string) ([]Release, error) {
-	url := fmt.Sprintf("/products/%s/releases", productSlug)
+	return r.ListWithContext(context.Background(), productSlug)
+}
 
-	var response ReleasesResponse
-	resp, err := r.client.MakeRequest("GET", url, http.StatusOK, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+func (r ReleasesService) ListWithContext(ctx context.Context, productSlug string) ([]Release, error) {
+	ctx, span := r.client.startSpan(ctx, "Releases.List", tracing.String(tracing.ProductSlug, productSlug))
+	defer span.End()
 
-	err = json.NewDecoder(resp.Body).Decode(&response)
+	var releases []Release
+	err := r.ListIterWithContext(ctx, productSlug, func(page []Release) error {
+		releases = append(releases, page...)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return response.Releases, nil
+	return releases, nil
+}
+
+// ListIter calls fn with each page of releases, following next links.
+// An error returned by fn stops the listing and is returned by ListIter.
+func (r ReleasesService) ListIter(productSlug string, fn func(page []Release) error) error {
+	return r.ListIterWithContext(context.Background(), productSlug, fn)
+}
+
+func (r ReleasesService) ListIterWithContext(ctx context.Context, productSlug string, fn func(page []Release) error) error {
+	ctx, span := r.client.startSpan(ctx, "Releases.ListIter", tracing.String(tracing.ProductSlug, productSlug))
+	defer span.End()
+
+	url := fmt.Sprintf("/products/%s/releases", productSlug)
+
+	return r.client.listPages(ctx, url, func(body io.Reader) (*Links, error) {
+		var response releasesPage
+		err := json.NewDecoder(body).Decode(&response)
+		if err != nil {
+			return nil, err
+		}
+
+		return response.Links, fn(response.Releases)
+	})
 }
 
 func (r ReleasesService) Get(productSlug string, releaseID int) (Release, error) {
+	return r.GetWithContext(context.Background(), productSlug, releaseID)
+}
+
+func (r ReleasesService) GetWithContext(ctx context.Context, productSlug string, releaseID int) (Release, error) {
+	ctx, span := r.client.startSpan(ctx, "Releases.Get",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf("/products/%s/releases/%d", productSlug, releaseID)
 
 	var response Release
-	resp, err := r.client.MakeRequest("GET", url, http.StatusOK, nil)
+	resp, err := r.client.MakeRequestWithContext(ctx, "GET", url, http.StatusOK, nil)
 	if err != nil {
 		return Release{}, err
 	}
@@ -101,6 +162,13 @@ func (r ReleasesService) Get(productSlug string, releaseID int) (Release, error)
 }
 
 func (r ReleasesService) Create(config CreateReleaseConfig) (Release, error) {
+	return r.CreateWithContext(context.Background(), config)
+}
+
+func (r ReleasesService) CreateWithContext(ctx context.Context, config CreateReleaseConfig) (Release, error) {
+	ctx, span := r.client.startSpan(ctx, "Releases.Create", tracing.String(tracing.ProductSlug, config.ProductSlug))
+	defer span.End()
+
 	url := fmt.Sprintf("/products/%s/releases", config.ProductSlug)
 
 	body := createReleaseBody{
@@ -139,7 +207,8 @@ func (r ReleasesService) Create(config CreateReleaseConfig) (Release, error) {
 	}
 
 	var response CreateReleaseResponse
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestWithContext(
+		ctx,
 		"POST",
 		url,
 		http.StatusCreated,
@@ -158,7 +227,173 @@ func (r ReleasesService) Create(config CreateReleaseConfig) (Release, error) {
 	return response.Release, nil
 }
 
+// Validate checks config before it is passed to Create, returning an
+// ErrValidation listing every invalid field.
+// The release type and EULA slug are checked against the values Pivnet
+// currently accepts.
+func (r ReleasesService) Validate(config CreateReleaseConfig) error {
+	return r.ValidateWithContext(context.Background(), config)
+}
+
+func (r ReleasesService) ValidateWithContext(ctx context.Context, config CreateReleaseConfig) error {
+	ctx, span := r.client.startSpan(ctx, "Releases.Validate",
+		tracing.String(tracing.ProductSlug, config.ProductSlug),
+	)
+	defer span.End()
+
+	var v validator
+
+	v.required("ProductSlug", config.ProductSlug)
+	v.required("Version", config.Version)
+	v.required("ReleaseType", config.ReleaseType)
+	v.required("EULASlug", config.EULASlug)
+
+	v.date("ReleaseDate", config.ReleaseDate)
+	v.date("EndOfSupportDate", config.EndOfSupportDate)
+	v.date("EndOfGuidanceDate", config.EndOfGuidanceDate)
+	v.date("EndOfAvailabilityDate", config.EndOfAvailabilityDate)
+
+	if config.ReleaseType != "" {
+		releaseTypes, err := ReleaseTypesService{client: r.client}.GetWithContext(ctx)
+		if err != nil {
+			v.lookupFailed(err)
+		} else {
+			allowed := make([]string, len(releaseTypes))
+			for i, releaseType := range releaseTypes {
+				allowed[i] = string(releaseType)
+			}
+
+			v.oneOf("ReleaseType", config.ReleaseType, allowed)
+		}
+	}
+
+	if config.EULASlug != "" {
+		eulas, err := EULAsService{client: r.client}.ListWithContext(ctx)
+		if err != nil {
+			v.lookupFailed(err)
+		} else {
+			allowed := make([]string, len(eulas))
+			for i, eula := range eulas {
+				allowed[i] = eula.Slug
+			}
+
+			v.oneOf("EULASlug", config.EULASlug, allowed)
+		}
+	}
+
+	return v.err()
+}
+
+// EnsureReleaseConfig configures ReleasesService.Ensure. Empty strings are
+// treated as unspecified. Controlled replaces the embedded
+// CreateReleaseConfig.Controlled, which is ignored, so that leaving it nil
+// keeps the setting of an existing release.
+type EnsureReleaseConfig struct {
+	CreateReleaseConfig
+	Controlled *bool
+}
+
+// CreateConfig returns the config used to create a missing release.
+func (c EnsureReleaseConfig) CreateConfig() CreateReleaseConfig {
+	config := c.CreateReleaseConfig
+	config.Controlled = c.Controlled != nil && *c.Controlled
+	return config
+}
+
+// ApplyTo returns release with the fields specified in c overlaid.
+func (c EnsureReleaseConfig) ApplyTo(release Release) Release {
+	desired := release
+	if release.EULA != nil {
+		eula := *release.EULA
+		desired.EULA = &eula
+	}
+
+	if c.EULASlug != "" {
+		desired.EULA = &EULA{Slug: c.EULASlug}
+	}
+	if c.ReleaseType != "" {
+		desired.ReleaseType = ReleaseType(c.ReleaseType)
+	}
+	if c.Controlled != nil {
+		desired.Controlled = *c.Controlled
+	}
+
+	setIfNotEmpty(&desired.ReleaseDate, c.ReleaseDate)
+	setIfNotEmpty(&desired.Description, c.Description)
+	setIfNotEmpty(&desired.ReleaseNotesURL, c.ReleaseNotesURL)
+	setIfNotEmpty(&desired.ECCN, c.ECCN)
+	setIfNotEmpty(&desired.LicenseException, c.LicenseException)
+	setIfNotEmpty(&desired.EndOfSupportDate, c.EndOfSupportDate)
+	setIfNotEmpty(&desired.EndOfGuidanceDate, c.EndOfGuidanceDate)
+	setIfNotEmpty(&desired.EndOfAvailabilityDate, c.EndOfAvailabilityDate)
+
+	return desired
+}
+
+// Ensure makes sure a release matching config exists, keyed by version.
+// An existing release is patched with any specified fields of config that
+// differ.
+func (r ReleasesService) Ensure(config EnsureReleaseConfig) (Release, EnsureAction, error) {
+	return r.EnsureWithContext(context.Background(), config)
+}
+
+func (r ReleasesService) EnsureWithContext(ctx context.Context, config EnsureReleaseConfig) (Release, EnsureAction, error) {
+	ctx, span := r.client.startSpan(ctx, "Releases.Ensure", tracing.String(tracing.ProductSlug, config.ProductSlug))
+	defer span.End()
+
+	releases, err := r.ListWithContext(ctx, config.ProductSlug)
+	if err != nil {
+		return Release{}, "", err
+	}
+
+	var existingID int
+	for _, release := range releases {
+		if release.Version == config.Version {
+			existingID = release.ID
+			break
+		}
+	}
+
+	if existingID == 0 {
+		release, err := r.CreateWithContext(ctx, config.CreateConfig())
+		if err != nil {
+			return Release{}, "", err
+		}
+
+		return release, EnsureActionCreated, nil
+	}
+
+	existing, err := r.GetWithContext(ctx, config.ProductSlug, existingID)
+	if err != nil {
+		return Release{}, "", err
+	}
+
+	desired := config.ApplyTo(existing)
+
+	fields := ChangedReleaseFields(existing, desired)
+	if len(fields) == 0 {
+		return existing, EnsureActionUnchanged, nil
+	}
+
+	updated, err := r.PatchWithContext(ctx, config.ProductSlug, desired, fields...)
+	if err != nil {
+		return Release{}, "", err
+	}
+
+	return updated, EnsureActionUpdated, nil
+}
+
 func (r ReleasesService) Update(productSlug string, release Release) (Release, error) {
+	return r.UpdateWithContext(context.Background(), productSlug, release)
+}
+
+func (r ReleasesService) UpdateWithContext(ctx context.Context, productSlug string, release Release) (Release, error) {
+	ctx, span := r.client.startSpan(ctx, "Releases.Update",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, release.ID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d",
 		productSlug,
@@ -179,7 +414,8 @@ func (r ReleasesService) Update(productSlug string, release Release) (Release, e
 	}
 
 	var response CreateReleaseResponse
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestWithContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusOK,
@@ -199,13 +435,24 @@ func (r ReleasesService) Update(productSlug string, release Release) (Release, e
 }
 
 func (r ReleasesService) Delete(productSlug string, release Release) error {
+	return r.DeleteWithContext(context.Background(), productSlug, release)
+}
+
+func (r ReleasesService) DeleteWithContext(ctx context.Context, productSlug string, release Release) error {
+	ctx, span := r.client.startSpan(ctx, "Releases.Delete",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, release.ID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d",
 		productSlug,
 		release.ID,
 	)
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestWithContext(
+		ctx,
 		"DELETE",
 		url,
 		http.StatusNoContent,
@@ -218,3 +465,105 @@ func (r ReleasesService) Delete(productSlug string, release Release) error {
 
 	return nil
 }
+
+// Patch sends only the release fields named by fields.
+//
+// If release.UpdatedAt is set, the current release is fetched first and
+// ErrConflict is returned if it has been updated since release was read.
+// This check is best-effort: the API has no conditional update, so an edit
+// made between the fetch and the PATCH is still overwritten.
+func (r ReleasesService) Patch(productSlug string, release Release, fields ...string) (Release, error) {
+	return r.PatchWithContext(context.Background(), productSlug, release, fields...)
+}
+
+func (r ReleasesService) PatchWithContext(ctx context.Context, productSlug string, release Release, fields ...string) (Release, error) {
+	ctx, span := r.client.startSpan(ctx, "Releases.Patch",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, release.ID),
+	)
+	defer span.End()
+
+	values, err := maskFields("release", releasePatchValues(release), fields)
+	if err != nil {
+		return Release{}, err
+	}
+
+	if release.UpdatedAt != "" {
+		current, err := r.GetWithContext(ctx, productSlug, release.ID)
+		if err != nil {
+			return Release{}, err
+		}
+
+		if current.UpdatedAt != release.UpdatedAt {
+			return Release{}, newErrConflict(fmt.Sprintf(
+				"release %d was updated at %s, expected %s",
+				release.ID,
+				current.UpdatedAt,
+				release.UpdatedAt,
+			))
+		}
+	}
+
+	url := fmt.Sprintf(
+		"/products/%s/releases/%d",
+		productSlug,
+		release.ID,
+	)
+
+	b, err := json.Marshal(patchBody{"release": values})
+	if err != nil {
+		// Untested as we cannot force an error because we are marshalling
+		// a known-good body
+		return Release{}, err
+	}
+
+	var response CreateReleaseResponse
+	resp, err := r.client.MakeRequestWithContext(
+		ctx,
+		"PATCH",
+		url,
+		http.StatusOK,
+		bytes.NewReader(b),
+	)
+	if err != nil {
+		return Release{}, err
+	}
+	defer resp.Body.Close()
+
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	if err != nil {
+		return Release{}, err
+	}
+
+	return response.Release, nil
+}
+
+// ChangedReleaseFields returns the fields that differ between original
+// and updated, suitable for passing to Patch.
+func ChangedReleaseFields(original Release, updated Release) []string {
+	return changedFields(releasePatchValues(original), releasePatchValues(updated))
+}
+
+func releasePatchValues(release Release) map[string]interface{} {
+	var eula *EULA
+	if release.EULA != nil {
+		eula = &EULA{Slug: release.EULA.Slug}
+	}
+
+	return map[string]interface{}{
+		ReleaseFieldAvailability:          release.Availability,
+		ReleaseFieldEULA:                  eula,
+		ReleaseFieldOSSCompliant:          release.OSSCompliant,
+		ReleaseFieldReleaseDate:           release.ReleaseDate,
+		ReleaseFieldReleaseType:           release.ReleaseType,
+		ReleaseFieldVersion:               release.Version,
+		ReleaseFieldDescription:           release.Description,
+		ReleaseFieldReleaseNotesURL:       release.ReleaseNotesURL,
+		ReleaseFieldControlled:            release.Controlled,
+		ReleaseFieldECCN:                  release.ECCN,
+		ReleaseFieldLicenseException:      release.LicenseException,
+		ReleaseFieldEndOfSupportDate:      release.EndOfSupportDate,
+		ReleaseFieldEndOfGuidanceDate:     release.EndOfGuidanceDate,
+		ReleaseFieldEndOfAvailabilityDate: release.EndOfAvailabilityDate,
+	}
+}
@@ -390,6 +390,302 @@ var _ = Describe("PivnetClient - product files", func() {
 		})
 	})
 
+	Describe("Validate", func() {
+		var (
+			createReleaseConfig pivnet.CreateReleaseConfig
+		)
+
+		BeforeEach(func() {
+			createReleaseConfig = pivnet.CreateReleaseConfig{
+				ProductSlug:      productSlug,
+				Version:          "1.2.3",
+				ReleaseType:      "Minor Release",
+				EULASlug:         "some-eula",
+				ReleaseDate:      "2017-01-31",
+				EndOfSupportDate: "2018-01-31",
+			}
+		})
+
+		appendLookupHandlers := func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", apiPrefix+"/releases/release_types"),
+					ghttp.RespondWith(http.StatusOK, `{"release_types":["Major Release","Minor Release"]}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", apiPrefix+"/eulas"),
+					ghttp.RespondWith(http.StatusOK, `{"eulas":[{"slug":"some-eula"},{"slug":"other-eula"}]}`),
+				),
+			)
+		}
+
+		It("returns no error for a valid config", func() {
+			appendLookupHandlers()
+
+			err := client.Releases.Validate(createReleaseConfig)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when several fields are invalid", func() {
+			BeforeEach(func() {
+				createReleaseConfig.Version = ""
+				createReleaseConfig.ReleaseType = "Minor Relase"
+				createReleaseConfig.EULASlug = "no-such-eula"
+				createReleaseConfig.ReleaseDate = "31/01/2017"
+				createReleaseConfig.EndOfSupportDate = "2018-02-30"
+			})
+
+			It("returns every problem in a single validation error", func() {
+				appendLookupHandlers()
+
+				err := client.Releases.Validate(createReleaseConfig)
+				Expect(err).To(BeAssignableToTypeOf(pivnet.ErrValidation{}))
+
+				var fields []string
+				for _, fieldErr := range err.(pivnet.ErrValidation).Errors {
+					fields = append(fields, fieldErr.Field)
+				}
+
+				Expect(fields).To(Equal([]string{
+					"Version",
+					"ReleaseDate",
+					"EndOfSupportDate",
+					"ReleaseType",
+					"EULASlug",
+				}))
+				Expect(err.Error()).To(ContainSubstring("ReleaseType: 'Minor Relase' is not one of"))
+			})
+		})
+
+		Context("when release type and EULA slug are empty", func() {
+			BeforeEach(func() {
+				createReleaseConfig.ReleaseType = ""
+				createReleaseConfig.EULASlug = ""
+			})
+
+			It("reports them as required without looking them up", func() {
+				err := client.Releases.Validate(createReleaseConfig)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("ReleaseType: must not be empty"))
+				Expect(err.Error()).To(ContainSubstring("EULASlug: must not be empty"))
+
+				Expect(server.ReceivedRequests()).To(BeEmpty())
+			})
+		})
+
+		Context("when looking up release types fails", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", apiPrefix+"/releases/release_types"),
+						ghttp.RespondWith(http.StatusTeapot, `{"message":"foo message"}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", apiPrefix+"/eulas"),
+						ghttp.RespondWith(http.StatusOK, `{"eulas":[{"slug":"some-eula"}]}`),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				err := client.Releases.Validate(createReleaseConfig)
+				Expect(err).NotTo(BeAssignableToTypeOf(pivnet.ErrValidation{}))
+				Expect(err.Error()).To(ContainSubstring("foo message"))
+			})
+
+			Context("when other fields are invalid", func() {
+				BeforeEach(func() {
+					createReleaseConfig.ReleaseDate = "31/01/2017"
+					createReleaseConfig.EULASlug = "no-such-eula"
+				})
+
+				It("returns the invalid fields with the error", func() {
+					err := client.Releases.Validate(createReleaseConfig)
+					Expect(err).To(BeAssignableToTypeOf(pivnet.ErrValidation{}))
+
+					validationErr := err.(pivnet.ErrValidation)
+					Expect(validationErr.Errors).To(HaveLen(2))
+					Expect(validationErr.Errors[0].Field).To(Equal("ReleaseDate"))
+					Expect(validationErr.Errors[1].Field).To(Equal("EULASlug"))
+					Expect(validationErr.LookupErr).To(MatchError(ContainSubstring("foo message")))
+					Expect(err.Error()).To(ContainSubstring("could not complete validation: 418 - foo message"))
+				})
+			})
+		})
+	})
+
+	Describe("Ensure", func() {
+		var (
+			ensureReleaseConfig pivnet.EnsureReleaseConfig
+			releasesURL         string
+			releaseURL          string
+		)
+
+		BeforeEach(func() {
+			ensureReleaseConfig = pivnet.EnsureReleaseConfig{
+				CreateReleaseConfig: pivnet.CreateReleaseConfig{
+					ProductSlug:     productSlug,
+					Version:         "1.2.3",
+					ReleaseType:     "Minor Release",
+					EULASlug:        "some-eula",
+					ReleaseDate:     "2017-01-31",
+					ReleaseNotesURL: "https://example.com/notes",
+				},
+			}
+
+			releasesURL = fmt.Sprintf("%s/products/%s/releases", apiPrefix, productSlug)
+			releaseURL = fmt.Sprintf("%s/products/%s/releases/%d", apiPrefix, productSlug, 42)
+		})
+
+		Context("when no release has the version", func() {
+			It("creates the release", func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", releasesURL),
+						ghttp.RespondWith(http.StatusOK, `{"releases":[{"id":41,"version":"1.2.2"}]}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", releasesURL),
+						ghttp.RespondWith(http.StatusCreated, `{"release":{"id":42,"version":"1.2.3"}}`),
+					),
+				)
+
+				release, action, err := client.Releases.Ensure(ensureReleaseConfig)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(action).To(Equal(pivnet.EnsureActionCreated))
+				Expect(release.ID).To(Equal(42))
+			})
+		})
+
+		Context("when a matching release already exists", func() {
+			It("returns the existing release", func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", releasesURL),
+						ghttp.RespondWith(http.StatusOK, `{"releases":[{"id":42,"version":"1.2.3"}]}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", releaseURL),
+						ghttp.RespondWith(http.StatusOK, `{
+							"id":42,
+							"version":"1.2.3",
+							"release_type":"Minor Release",
+							"eula":{"id":7,"slug":"some-eula"},
+							"release_date":"2017-01-31",
+							"release_notes_url":"https://example.com/notes",
+							"description":"left alone"
+						}`),
+					),
+				)
+
+				release, action, err := client.Releases.Ensure(ensureReleaseConfig)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(action).To(Equal(pivnet.EnsureActionUnchanged))
+				Expect(release.Description).To(Equal("left alone"))
+			})
+		})
+
+		Context("when an existing release differs", func() {
+			It("patches only the differing fields", func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", releasesURL),
+						ghttp.RespondWith(http.StatusOK, `{"releases":[{"id":42,"version":"1.2.3"}]}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", releaseURL),
+						ghttp.RespondWith(http.StatusOK, `{
+							"id":42,
+							"version":"1.2.3",
+							"release_type":"Major Release",
+							"eula":{"slug":"some-eula"},
+							"release_date":"2017-01-31",
+							"updated_at":"2017-01-31T00:00:00.000Z"
+						}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", releaseURL),
+						ghttp.RespondWith(http.StatusOK, `{"id":42,"updated_at":"2017-01-31T00:00:00.000Z"}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PATCH", releaseURL),
+						ghttp.VerifyJSON(`{"release":{
+							"release_type":"Minor Release",
+							"release_notes_url":"https://example.com/notes"
+						}}`),
+						ghttp.RespondWith(http.StatusOK, `{"release":{"id":42,"version":"1.2.3"}}`),
+					),
+				)
+
+				release, action, err := client.Releases.Ensure(ensureReleaseConfig)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(action).To(Equal(pivnet.EnsureActionUpdated))
+				Expect(release.ID).To(Equal(42))
+			})
+		})
+
+		Context("when an existing release is controlled", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", releasesURL),
+						ghttp.RespondWith(http.StatusOK, `{"releases":[{"id":42,"version":"1.2.3"}]}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", releaseURL),
+						ghttp.RespondWith(http.StatusOK, `{
+							"id":42,
+							"version":"1.2.3",
+							"release_type":"Minor Release",
+							"eula":{"slug":"some-eula"},
+							"release_date":"2017-01-31",
+							"release_notes_url":"https://example.com/notes",
+							"controlled":true
+						}`),
+					),
+				)
+			})
+
+			It("leaves it controlled when Controlled is unspecified", func() {
+				release, action, err := client.Releases.Ensure(ensureReleaseConfig)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(action).To(Equal(pivnet.EnsureActionUnchanged))
+				Expect(release.Controlled).To(BeTrue())
+			})
+
+			It("patches it when Controlled is set to false", func() {
+				controlled := false
+				ensureReleaseConfig.Controlled = &controlled
+
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PATCH", releaseURL),
+						ghttp.VerifyJSON(`{"release":{"controlled":false}}`),
+						ghttp.RespondWith(http.StatusOK, `{"release":{"id":42,"version":"1.2.3"}}`),
+					),
+				)
+
+				_, action, err := client.Releases.Ensure(ensureReleaseConfig)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(action).To(Equal(pivnet.EnsureActionUpdated))
+			})
+		})
+
+		Context("when listing releases fails", func() {
+			It("returns the error", func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", releasesURL),
+						ghttp.RespondWith(http.StatusTeapot, `{"message":"foo message"}`),
+					),
+				)
+
+				_, _, err := client.Releases.Ensure(ensureReleaseConfig)
+				Expect(err.Error()).To(ContainSubstring("foo message"))
+			})
+		})
+	})
+
 	Describe("Update", func() {
 		It("submits the updated values for a release with OSS compliance", func() {
 			release := pivnet.Release{
@@ -466,6 +762,163 @@ var _ = Describe("PivnetClient - product files", func() {
 		})
 	})
 
+	Describe("Patch", func() {
+		var (
+			release  pivnet.Release
+			patchURL string
+		)
+
+		BeforeEach(func() {
+			release = pivnet.Release{
+				ID:              42,
+				Version:         "1.2.3.4",
+				ReleaseNotesURL: "https://example.com/notes",
+				Controlled:      false,
+				Links: &pivnet.Links{
+					ProductFiles: map[string]string{"href": "https://banana.org/cookies"},
+				},
+			}
+
+			patchURL = fmt.Sprintf("%s/products/%s/releases/%d", apiPrefix, "banana-slug", release.ID)
+		})
+
+		It("submits only the requested fields", func() {
+			response := `{"release": {"id": 42, "version": "1.2.3.4"}}`
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PATCH", patchURL),
+					ghttp.VerifyJSON(`{"release":{"release_notes_url":"https://example.com/notes","controlled":false}}`),
+					ghttp.RespondWith(http.StatusOK, response),
+				),
+			)
+
+			updated, err := client.Releases.Patch(
+				"banana-slug",
+				release,
+				pivnet.ReleaseFieldReleaseNotesURL,
+				pivnet.ReleaseFieldControlled,
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.Version).To(Equal("1.2.3.4"))
+		})
+
+		It("sends only the EULA slug", func() {
+			release.EULA = &pivnet.EULA{Slug: "some-eula", ID: 15, Name: "Some EULA"}
+
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PATCH", patchURL),
+					ghttp.VerifyJSON(`{"release":{"eula":{"slug":"some-eula"}}}`),
+					ghttp.RespondWith(http.StatusOK, `{"release": {"id": 42}}`),
+				),
+			)
+
+			_, err := client.Releases.Patch("banana-slug", release, pivnet.ReleaseFieldEULA)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when no fields are provided", func() {
+			It("returns an error without making a request", func() {
+				_, err := client.Releases.Patch("banana-slug", release)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("at least one release field"))
+
+				Expect(server.ReceivedRequests()).To(BeEmpty())
+			})
+		})
+
+		Context("when an unknown field is provided", func() {
+			It("returns an error without making a request", func() {
+				_, err := client.Releases.Patch("banana-slug", release, "_links")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("unknown release field: '_links'"))
+
+				Expect(server.ReceivedRequests()).To(BeEmpty())
+			})
+		})
+
+		Context("when UpdatedAt is set", func() {
+			BeforeEach(func() {
+				release.UpdatedAt = "2017-01-01T00:00:00.000Z"
+			})
+
+			It("patches the release when it has not changed since it was read", func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", patchURL),
+						ghttp.RespondWith(http.StatusOK, `{"id": 42, "updated_at": "2017-01-01T00:00:00.000Z"}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PATCH", patchURL),
+						ghttp.VerifyJSON(`{"release":{"version":"1.2.3.4"}}`),
+						ghttp.RespondWith(http.StatusOK, `{"release": {"id": 42}}`),
+					),
+				)
+
+				_, err := client.Releases.Patch("banana-slug", release, pivnet.ReleaseFieldVersion)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns a conflict error when the release has changed since it was read", func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", patchURL),
+						ghttp.RespondWith(http.StatusOK, `{"id": 42, "updated_at": "2017-02-02T00:00:00.000Z"}`),
+					),
+				)
+
+				_, err := client.Releases.Patch("banana-slug", release, pivnet.ReleaseFieldVersion)
+				Expect(err).To(BeAssignableToTypeOf(pivnet.ErrConflict{}))
+				Expect(err.Error()).To(ContainSubstring("2017-02-02T00:00:00.000Z"))
+
+				Expect(server.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+
+		Context("when the server responds with a non-200 status code", func() {
+			It("returns the error", func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PATCH", patchURL),
+						ghttp.RespondWith(http.StatusTeapot, `{"message":"foo message"}`),
+					),
+				)
+
+				_, err := client.Releases.Patch("banana-slug", release, pivnet.ReleaseFieldVersion)
+				Expect(err.Error()).To(ContainSubstring("foo message"))
+			})
+		})
+	})
+
+	Describe("ChangedReleaseFields", func() {
+		It("returns the fields that differ", func() {
+			original := pivnet.Release{
+				ID:         42,
+				Version:    "1.2.3",
+				EULA:       &pivnet.EULA{Slug: "some-eula", ID: 15},
+				Controlled: true,
+				UpdatedAt:  "2017-01-01T00:00:00.000Z",
+			}
+			updated := pivnet.Release{
+				ID:          42,
+				Version:     "1.2.4",
+				EULA:        &pivnet.EULA{Slug: "some-eula"},
+				Description: "some description",
+			}
+
+			Expect(pivnet.ChangedReleaseFields(original, updated)).To(Equal([]string{
+				pivnet.ReleaseFieldControlled,
+				pivnet.ReleaseFieldDescription,
+				pivnet.ReleaseFieldVersion,
+			}))
+		})
+
+		It("returns no fields for identical releases", func() {
+			release := pivnet.Release{ID: 42, Version: "1.2.3"}
+			Expect(pivnet.ChangedReleaseFields(release, release)).To(BeEmpty())
+		})
+	})
+
 	Describe("Delete", func() {
 		var (
 			release pivnet.Release
@@ -0,0 +1,214 @@
+package pivnet
+
+import (
+	"context"
+	"io"
+	"os"
+)
+
+// The interfaces below are implemented by the services of the same name.
+// Client holds its services by these interfaces, so a Client can be built
+// from fakes, such as those in pivnetfakes:
+//
+//	client := pivnet.Client{Releases: &pivnetfakes.FakeReleasesAPI{}}
+//
+// Each method making requests has a WithContext variant, whose context
+// cancels the requests and holds the parent of the span for the call.
+
+//go:generate counterfeiter -o pivnetfakes . AuthAPI
+
+type AuthAPI interface {
+	Check() (bool, error)
+	CheckWithContext(ctx context.Context) (bool, error)
+}
+
+//go:generate counterfeiter -o pivnetfakes . EULAsAPI
+
+type EULAsAPI interface {
+	List() ([]EULA, error)
+	ListWithContext(ctx context.Context) ([]EULA, error)
+	Get(eulaSlug string) (EULA, error)
+	GetWithContext(ctx context.Context, eulaSlug string) (EULA, error)
+	Accept(productSlug string, releaseID int) error
+	AcceptWithContext(ctx context.Context, productSlug string, releaseID int) error
+}
+
+//go:generate counterfeiter -o pivnetfakes . ProductFilesAPI
+
+type ProductFilesAPI interface {
+	List(productSlug string) ([]ProductFile, error)
+	ListWithContext(ctx context.Context, productSlug string) ([]ProductFile, error)
+	ListIter(productSlug string, fn func(page []ProductFile) error) error
+	ListIterWithContext(ctx context.Context, productSlug string, fn func(page []ProductFile) error) error
+	ListForRelease(productSlug string, releaseID int) ([]ProductFile, error)
+	ListForReleaseWithContext(ctx context.Context, productSlug string, releaseID int) ([]ProductFile, error)
+	Get(productSlug string, productFileID int) (ProductFile, error)
+	GetWithContext(ctx context.Context, productSlug string, productFileID int) (ProductFile, error)
+	GetForRelease(productSlug string, releaseID int, productFileID int) (ProductFile, error)
+	GetForReleaseWithContext(ctx context.Context, productSlug string, releaseID int, productFileID int) (ProductFile, error)
+	Create(config CreateProductFileConfig) (ProductFile, error)
+	CreateWithContext(ctx context.Context, config CreateProductFileConfig) (ProductFile, error)
+	Validate(config CreateProductFileConfig) error
+	Ensure(config CreateProductFileConfig) (ProductFile, EnsureAction, error)
+	EnsureWithContext(ctx context.Context, config CreateProductFileConfig) (ProductFile, EnsureAction, error)
+	Update(productSlug string, productFile ProductFile) (ProductFile, error)
+	UpdateWithContext(ctx context.Context, productSlug string, productFile ProductFile) (ProductFile, error)
+	Patch(productSlug string, productFile ProductFile, fields ...string) (ProductFile, error)
+	PatchWithContext(ctx context.Context, productSlug string, productFile ProductFile, fields ...string) (ProductFile, error)
+	Delete(productSlug string, id int) (ProductFile, error)
+	DeleteWithContext(ctx context.Context, productSlug string, id int) (ProductFile, error)
+	AddToRelease(productSlug string, releaseID int, productFileID int) error
+	AddToReleaseWithContext(ctx context.Context, productSlug string, releaseID int, productFileID int) error
+	RemoveFromRelease(productSlug string, releaseID int, productFileID int) error
+	RemoveFromReleaseWithContext(ctx context.Context, productSlug string, releaseID int, productFileID int) error
+	AddToFileGroup(productSlug string, fileGroupID int, productFileID int) error
+	AddToFileGroupWithContext(ctx context.Context, productSlug string, fileGroupID int, productFileID int) error
+	RemoveFromFileGroup(productSlug string, fileGroupID int, productFileID int) error
+	RemoveFromFileGroupWithContext(ctx context.Context, productSlug string, fileGroupID int, productFileID int) error
+	DownloadForRelease(location *os.File, productSlug string, releaseID int, productFileID int, progressWriter io.Writer) error
+	DownloadForReleaseWithContext(ctx context.Context, location *os.File, productSlug string, releaseID int, productFileID int, progressWriter io.Writer) error
+	WaitUntilReady(ctx context.Context, productSlug string, productFileIDs []int, config WaitConfig) ([]ProductFile, error)
+}
+
+//go:generate counterfeiter -o pivnetfakes . FileGroupsAPI
+
+type FileGroupsAPI interface {
+	List(productSlug string) ([]FileGroup, error)
+	ListWithContext(ctx context.Context, productSlug string) ([]FileGroup, error)
+	Get(productSlug string, fileGroupID int) (FileGroup, error)
+	GetWithContext(ctx context.Context, productSlug string, fileGroupID int) (FileGroup, error)
+	Create(config CreateFileGroupConfig) (FileGroup, error)
+	CreateWithContext(ctx context.Context, config CreateFileGroupConfig) (FileGroup, error)
+	Ensure(config CreateFileGroupConfig) (FileGroup, EnsureAction, error)
+	EnsureWithContext(ctx context.Context, config CreateFileGroupConfig) (FileGroup, EnsureAction, error)
+	Update(productSlug string, fileGroup FileGroup) (FileGroup, error)
+	UpdateWithContext(ctx context.Context, productSlug string, fileGroup FileGroup) (FileGroup, error)
+	Delete(productSlug string, id int) (FileGroup, error)
+	DeleteWithContext(ctx context.Context, productSlug string, id int) (FileGroup, error)
+	ListForRelease(productSlug string, releaseID int) ([]FileGroup, error)
+	ListForReleaseWithContext(ctx context.Context, productSlug string, releaseID int) ([]FileGroup, error)
+	AddToRelease(productSlug string, releaseID int, fileGroupID int) error
+	AddToReleaseWithContext(ctx context.Context, productSlug string, releaseID int, fileGroupID int) error
+	RemoveFromRelease(productSlug string, releaseID int, fileGroupID int) error
+	RemoveFromReleaseWithContext(ctx context.Context, productSlug string, releaseID int, fileGroupID int) error
+}
+
+//go:generate counterfeiter -o pivnetfakes . ReleasesAPI
+
+type ReleasesAPI interface {
+	List(productSlug string) ([]Release, error)
+	ListWithContext(ctx context.Context, productSlug string) ([]Release, error)
+	ListIter(productSlug string, fn func(page []Release) error) error
+	ListIterWithContext(ctx context.Context, productSlug string, fn func(page []Release) error) error
+	Get(productSlug string, releaseID int) (Release, error)
+	GetWithContext(ctx context.Context, productSlug string, releaseID int) (Release, error)
+	Create(config CreateReleaseConfig) (Release, error)
+	CreateWithContext(ctx context.Context, config CreateReleaseConfig) (Release, error)
+	Validate(config CreateReleaseConfig) error
+	ValidateWithContext(ctx context.Context, config CreateReleaseConfig) error
+	Ensure(config EnsureReleaseConfig) (Release, EnsureAction, error)
+	EnsureWithContext(ctx context.Context, config EnsureReleaseConfig) (Release, EnsureAction, error)
+	Update(productSlug string, release Release) (Release, error)
+	UpdateWithContext(ctx context.Context, productSlug string, release Release) (Release, error)
+	Delete(productSlug string, release Release) error
+	DeleteWithContext(ctx context.Context, productSlug string, release Release) error
+	Patch(productSlug string, release Release, fields ...string) (Release, error)
+	PatchWithContext(ctx context.Context, productSlug string, release Release, fields ...string) (Release, error)
+}
+
+//go:generate counterfeiter -o pivnetfakes . ProductsAPI
+
+type ProductsAPI interface {
+	List() ([]Product, error)
+	ListWithContext(ctx context.Context) ([]Product, error)
+	ListIter(fn func(page []Product) error) error
+	ListIterWithContext(ctx context.Context, fn func(page []Product) error) error
+	Get(slug string) (Product, error)
+	GetWithContext(ctx context.Context, slug string) (Product, error)
+}
+
+//go:generate counterfeiter -o pivnetfakes . UserGroupsAPI
+
+type UserGroupsAPI interface {
+	List() ([]UserGroup, error)
+	ListWithContext(ctx context.Context) ([]UserGroup, error)
+	ListIter(fn func(page []UserGroup) error) error
+	ListIterWithContext(ctx context.Context, fn func(page []UserGroup) error) error
+	ListForRelease(productSlug string, releaseID int) ([]UserGroup, error)
+	ListForReleaseWithContext(ctx context.Context, productSlug string, releaseID int) ([]UserGroup, error)
+	AddToRelease(productSlug string, releaseID int, userGroupID int) error
+	AddToReleaseWithContext(ctx context.Context, productSlug string, releaseID int, userGroupID int) error
+	RemoveFromRelease(productSlug string, releaseID int, userGroupID int) error
+	RemoveFromReleaseWithContext(ctx context.Context, productSlug string, releaseID int, userGroupID int) error
+	Get(userGroupID int) (UserGroup, error)
+	GetWithContext(ctx context.Context, userGroupID int) (UserGroup, error)
+	Create(name string, description string, members []string) (UserGroup, error)
+	CreateWithContext(ctx context.Context, name string, description string, members []string) (UserGroup, error)
+	Ensure(name string, description string, members []string) (UserGroup, EnsureAction, error)
+	EnsureWithContext(ctx context.Context, name string, description string, members []string) (UserGroup, EnsureAction, error)
+	Update(userGroup UserGroup) (UserGroup, error)
+	UpdateWithContext(ctx context.Context, userGroup UserGroup) (UserGroup, error)
+	Delete(userGroupID int) error
+	DeleteWithContext(ctx context.Context, userGroupID int) error
+	AddMemberToGroup(userGroupID int, memberEmailAddress string, admin bool) (UserGroup, error)
+	AddMemberToGroupWithContext(ctx context.Context, userGroupID int, memberEmailAddress string, admin bool) (UserGroup, error)
+	RemoveMemberFromGroup(userGroupID int, memberEmailAddress string) (UserGroup, error)
+	RemoveMemberFromGroupWithContext(ctx context.Context, userGroupID int, memberEmailAddress string) (UserGroup, error)
+}
+
+//go:generate counterfeiter -o pivnetfakes . ReleaseTypesAPI
+
+type ReleaseTypesAPI interface {
+	Get() ([]ReleaseType, error)
+	GetWithContext(ctx context.Context) ([]ReleaseType, error)
+}
+
+//go:generate counterfeiter -o pivnetfakes . ReleaseDependenciesAPI
+
+type ReleaseDependenciesAPI interface {
+	List(productSlug string, releaseID int) ([]ReleaseDependency, error)
+	ListWithContext(ctx context.Context, productSlug string, releaseID int) ([]ReleaseDependency, error)
+	Add(productSlug string, releaseID int, dependentReleaseID int) error
+	AddWithContext(ctx context.Context, productSlug string, releaseID int, dependentReleaseID int) error
+	Remove(productSlug string, releaseID int, dependentReleaseID int) error
+	RemoveWithContext(ctx context.Context, productSlug string, releaseID int, dependentReleaseID int) error
+}
+
+//go:generate counterfeiter -o pivnetfakes . DependencySpecifiersAPI
+
+type DependencySpecifiersAPI interface {
+	List(productSlug string, releaseID int) ([]DependencySpecifier, error)
+	ListWithContext(ctx context.Context, productSlug string, releaseID int) ([]DependencySpecifier, error)
+	Get(productSlug string, releaseID int, dependencySpecifierID int) (DependencySpecifier, error)
+	GetWithContext(ctx context.Context, productSlug string, releaseID int, dependencySpecifierID int) (DependencySpecifier, error)
+	Create(productSlug string, releaseID int, dependentProductSlug string, specifier string) (DependencySpecifier, error)
+	CreateWithContext(ctx context.Context, productSlug string, releaseID int, dependentProductSlug string, specifier string) (DependencySpecifier, error)
+	Delete(productSlug string, releaseID int, dependencySpecifierID int) error
+	DeleteWithContext(ctx context.Context, productSlug string, releaseID int, dependencySpecifierID int) error
+	Resolve(productSlug string, releaseID int) ([]ResolvedDependencySpecifier, error)
+	ResolveWithContext(ctx context.Context, productSlug string, releaseID int) ([]ResolvedDependencySpecifier, error)
+}
+
+//go:generate counterfeiter -o pivnetfakes . ReleaseUpgradePathsAPI
+
+type ReleaseUpgradePathsAPI interface {
+	Get(productSlug string, releaseID int) ([]ReleaseUpgradePath, error)
+	GetWithContext(ctx context.Context, productSlug string, releaseID int) ([]ReleaseUpgradePath, error)
+	Add(productSlug string, releaseID int, previousReleaseID int) error
+	AddWithContext(ctx context.Context, productSlug string, releaseID int, previousReleaseID int) error
+	Remove(productSlug string, releaseID int, previousReleaseID int) error
+	RemoveWithContext(ctx context.Context, productSlug string, releaseID int, previousReleaseID int) error
+}
+
+//go:generate counterfeiter -o pivnetfakes . UpgradePathSpecifiersAPI
+
+type UpgradePathSpecifiersAPI interface {
+	List(productSlug string, releaseID int) ([]UpgradePathSpecifier, error)
+	ListWithContext(ctx context.Context, productSlug string, releaseID int) ([]UpgradePathSpecifier, error)
+	Get(productSlug string, releaseID int, upgradePathSpecifierID int) (UpgradePathSpecifier, error)
+	GetWithContext(ctx context.Context, productSlug string, releaseID int, upgradePathSpecifierID int) (UpgradePathSpecifier, error)
+	Create(productSlug string, releaseID int, specifier string) (UpgradePathSpecifier, error)
+	CreateWithContext(ctx context.Context, productSlug string, releaseID int, specifier string) (UpgradePathSpecifier, error)
+	Delete(productSlug string, releaseID int, upgradePathSpecifierID int) error
+	DeleteWithContext(ctx context.Context, productSlug string, releaseID int, upgradePathSpecifierID int) error
+}
@@ -0,0 +1,34 @@
+package pivnet_test
+
+import (
+	"errors"
+
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/pivnetfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PivnetClient - services", func() {
+	It("can be built from fake services", func() {
+		fakeReleases := &pivnetfakes.FakeReleasesAPI{}
+		fakeReleases.ListReturns([]pivnet.Release{{ID: 1234, Version: "1.2.3"}}, nil)
+
+		fakeProductFiles := &pivnetfakes.FakeProductFilesAPI{}
+		fakeProductFiles.GetReturns(pivnet.ProductFile{}, errors.New("some error"))
+
+		client := pivnet.Client{
+			Releases:     fakeReleases,
+			ProductFiles: fakeProductFiles,
+		}
+
+		releases, err := client.Releases.List(productSlug)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(releases).To(Equal([]pivnet.Release{{ID: 1234, Version: "1.2.3"}}))
+		Expect(fakeReleases.ListArgsForCall(0)).To(Equal(productSlug))
+
+		_, err = client.ProductFiles.Get(productSlug, 5678)
+		Expect(err).To(MatchError("some error"))
+	})
+})
@@ -0,0 +1,190 @@
+// Package specifier parses and evaluates the version specifiers used by
+// Pivotal Network dependency and upgrade path specifiers.
+//
+// A specifier is one or more comma-separated constraints, all of which
+// a version must satisfy:
+//
+//	1.2.3        exactly 1.2.3
+//	1.2.*        any version starting 1.2
+//	~> 1.2       at least 1.2 and below 2.0
+//	~> 1.2.3     at least 1.2.3 and below 1.3
+//	>= 1.2, < 2  any of =, !=, >, >=, <, <= followed by a version
+//
+// Other constraints not starting with a digit, such as "Spring 2017",
+// match that version exactly.
+package specifier
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Specifier struct {
+	raw         string
+	constraints []constraint
+}
+
+type constraint struct {
+	op       string
+	version  Version
+	wildcard []int
+	exact    string
+}
+
+var operators = []string{"~>", ">=", "<=", "!=", "=", ">", "<"}
+
+func Parse(s string) (Specifier, error) {
+	if strings.TrimSpace(s) == "" {
+		return Specifier{}, fmt.Errorf("invalid specifier: must not be empty")
+	}
+
+	var constraints []constraint
+	for _, part := range strings.Split(s, ",") {
+		c, err := parseConstraint(strings.TrimSpace(part))
+		if err != nil {
+			return Specifier{}, fmt.Errorf("invalid specifier '%s': %s", s, err)
+		}
+		constraints = append(constraints, c)
+	}
+
+	return Specifier{
+		raw:         s,
+		constraints: constraints,
+	}, nil
+}
+
+func MustParse(s string) Specifier {
+	spec, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return spec
+}
+
+func parseConstraint(s string) (constraint, error) {
+	if s == "" {
+		return constraint{}, fmt.Errorf("empty constraint")
+	}
+
+	op := ""
+	for _, o := range operators {
+		if strings.HasPrefix(s, o) {
+			op = o
+			s = strings.TrimSpace(strings.TrimPrefix(s, o))
+			break
+		}
+	}
+
+	if strings.HasSuffix(s, "*") {
+		if op != "" {
+			return constraint{}, fmt.Errorf("wildcard '%s' cannot be used with '%s'", s, op)
+		}
+		return parseWildcard(s)
+	}
+
+	v, err := ParseVersion(s)
+	if err != nil {
+		// Versions that are not numeric can still be matched exactly, but
+		// a leading digit means a mistyped version, such as 1.2.x
+		if op == "" && !startsWithDigit(s) {
+			return constraint{op: "==", exact: s}, nil
+		}
+		return constraint{}, err
+	}
+
+	if op == "~>" && len(v.segments) < 2 {
+		return constraint{}, fmt.Errorf("'~>' requires at least major and minor versions, got '%s'", s)
+	}
+
+	if op == "" {
+		op = "="
+	}
+
+	return constraint{op: op, version: v}, nil
+}
+
+func startsWithDigit(s string) bool {
+	return s[0] >= '0' && s[0] <= '9'
+}
+
+func parseWildcard(s string) (constraint, error) {
+	prefix := strings.TrimSuffix(strings.TrimSuffix(s, "*"), ".")
+	if prefix == "" {
+		return constraint{op: "*"}, nil
+	}
+
+	v, err := ParseVersion(prefix)
+	if err != nil || v.prerelease != "" {
+		return constraint{}, fmt.Errorf("invalid wildcard: '%s'", s)
+	}
+
+	return constraint{op: "*", wildcard: v.segments}, nil
+}
+
+func (s Specifier) String() string {
+	return s.raw
+}
+
+// Matches reports whether version satisfies every constraint of s.
+// Versions that cannot be parsed only match exact constraints.
+func (s Specifier) Matches(version string) bool {
+	v, err := ParseVersion(version)
+	parsed := err == nil
+
+	for _, c := range s.constraints {
+		if c.op == "==" {
+			if strings.TrimSpace(version) != c.exact {
+				return false
+			}
+			continue
+		}
+
+		if !parsed || !c.matches(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c constraint) matches(v Version) bool {
+	switch c.op {
+	case "*":
+		for i, segment := range c.wildcard {
+			if v.segment(i) != segment {
+				return false
+			}
+		}
+		return true
+	case "~>":
+		if v.Compare(c.version) < 0 {
+			return false
+		}
+		// ~> 1.2 allows 1.x; ~> 1.2.3 allows 1.2.x
+		fixed := len(c.version.segments) - 1
+		for i := 0; i < fixed; i++ {
+			if v.segment(i) != c.version.segments[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	cmp := v.Compare(c.version)
+	switch c.op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	}
+
+	return false
+}
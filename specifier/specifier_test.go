@@ -0,0 +1,59 @@
+package specifier_test
+
+import (
+	"github.com/pivotal-cf/go-pivnet/specifier"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Specifier", func() {
+	DescribeTable("Matches",
+		func(spec string, version string, expected bool) {
+			s, err := specifier.Parse(spec)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s.Matches(version)).To(Equal(expected))
+		},
+		Entry("exact match", "1.2.3", "1.2.3", true),
+		Entry("exact mismatch", "1.2.3", "1.2.4", false),
+		Entry("exact non-numeric match", "Spring 2017", "Spring 2017", true),
+		Entry("wildcard match", "1.2.*", "1.2.9", true),
+		Entry("wildcard matches pre-release", "1.2.*", "1.2.0-rc.1", true),
+		Entry("wildcard matches shorter version", "1.2.*", "1.2", true),
+		Entry("wildcard mismatch", "1.2.*", "1.3.0", false),
+		Entry("wildcard does not match by string prefix", "1.2.*", "1.20.0", false),
+		Entry("bare wildcard", "*", "4.5.6", true),
+		Entry("pessimistic minor lower bound", "~> 1.2", "1.1.9", false),
+		Entry("pessimistic minor", "~> 1.2", "1.9.0", true),
+		Entry("pessimistic minor upper bound", "~> 1.2", "2.0.0", false),
+		Entry("pessimistic patch", "~> 1.2.3", "1.2.9", true),
+		Entry("pessimistic patch upper bound", "~> 1.2.3", "1.3.0", false),
+		Entry("greater than or equal", ">= 1.2", "1.2.0", true),
+		Entry("less than", "< 2", "2.0.0-rc.1", true),
+		Entry("not equal", "!= 1.2.3", "1.2.3", false),
+		Entry("all constraints must match", ">= 1.2, < 1.4", "1.3.5", true),
+		Entry("all constraints must match", ">= 1.2, < 1.4", "1.4.0", false),
+		Entry("unparseable versions do not match ranges", ">= 1.2", "Spring 2017", false),
+	)
+
+	DescribeTable("Parse errors",
+		func(spec string, message string) {
+			_, err := specifier.Parse(spec)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(message))
+		},
+		Entry("empty", " ", "must not be empty"),
+		Entry("empty constraint", "1.2.*,", "empty constraint"),
+		Entry("operator with wildcard", ">= 1.2.*", "cannot be used with"),
+		Entry("operator with non-numeric version", ">= banana", "invalid version: 'banana'"),
+		Entry("pessimistic with major only", "~> 1", "requires at least major and minor"),
+		Entry("non-numeric wildcard", "a.*", "invalid wildcard"),
+		Entry("letter in a numeric version", "1.2.x", "invalid version: '1.2.x'"),
+		Entry("empty segment", "1..2", "invalid version: '1..2'"),
+	)
+
+	It("returns the original specifier from String", func() {
+		Expect(specifier.MustParse("~> 1.2").String()).To(Equal("~> 1.2"))
+	})
+})
@@ -0,0 +1,142 @@
+package specifier
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is a dotted numeric release version with an optional
+// pre-release suffix, e.g. 1.12.0 or 2.0.0-rc.1.
+type Version struct {
+	segments   []int
+	prerelease string
+	raw        string
+}
+
+func ParseVersion(s string) (Version, error) {
+	raw := s
+	s = strings.TrimPrefix(strings.TrimSpace(s), "v")
+
+	var prerelease string
+	if i := strings.IndexAny(s, "-+"); i >= 0 {
+		prerelease = s[i+1:]
+		s = s[:i]
+	}
+
+	parts := strings.Split(s, ".")
+	segments := make([]int, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return Version{}, fmt.Errorf("invalid version: '%s'", raw)
+		}
+		segments[i] = n
+	}
+
+	return Version{
+		segments:   segments,
+		prerelease: prerelease,
+		raw:        raw,
+	}, nil
+}
+
+func (v Version) String() string {
+	return v.raw
+}
+
+func (v Version) Prerelease() bool {
+	return v.prerelease != ""
+}
+
+// Compare returns -1, 0 or 1 if v is older than, the same as, or newer
+// than o. Missing segments are treated as zero, and a pre-release is
+// older than the release it precedes.
+func (v Version) Compare(o Version) int {
+	n := len(v.segments)
+	if len(o.segments) > n {
+		n = len(o.segments)
+	}
+
+	for i := 0; i < n; i++ {
+		a, b := v.segment(i), o.segment(i)
+		if a != b {
+			return compareInts(a, b)
+		}
+	}
+
+	switch {
+	case v.prerelease == o.prerelease:
+		return 0
+	case v.prerelease == "":
+		return 1
+	case o.prerelease == "":
+		return -1
+	default:
+		return comparePrerelease(v.prerelease, o.prerelease)
+	}
+}
+
+func (v Version) segment(i int) int {
+	if i < len(v.segments) {
+		return v.segments[i]
+	}
+	return 0
+}
+
+// Compare orders two version strings. Versions that cannot be parsed
+// are older than any that can, and are ordered by string among themselves.
+func Compare(a string, b string) int {
+	va, errA := ParseVersion(a)
+	vb, errB := ParseVersion(b)
+
+	switch {
+	case errA == nil && errB == nil:
+		return va.Compare(vb)
+	case errA == nil:
+		return 1
+	case errB == nil:
+		return -1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+func comparePrerelease(a string, b string) int {
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, errA := strconv.Atoi(as[i])
+		bn, errB := strconv.Atoi(bs[i])
+
+		var c int
+		switch {
+		case errA == nil && errB == nil:
+			c = compareInts(an, bn)
+		case errA == nil:
+			c = -1
+		case errB == nil:
+			c = 1
+		default:
+			c = strings.Compare(as[i], bs[i])
+		}
+
+		if c != 0 {
+			return c
+		}
+	}
+
+	return compareInts(len(as), len(bs))
+}
+
+func compareInts(a int, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,40 @@
+package specifier_test
+
+import (
+	"github.com/pivotal-cf/go-pivnet/specifier"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Version", func() {
+	Describe("ParseVersion", func() {
+		It("parses dotted numeric versions with a pre-release suffix", func() {
+			v, err := specifier.ParseVersion("2.0.0-rc.1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(v.String()).To(Equal("2.0.0-rc.1"))
+			Expect(v.Prerelease()).To(BeTrue())
+		})
+
+		It("returns an error for non-numeric versions", func() {
+			_, err := specifier.ParseVersion("Spring 2017")
+			Expect(err).To(MatchError("invalid version: 'Spring 2017'"))
+		})
+	})
+
+	DescribeTable("Compare",
+		func(a string, b string, expected int) {
+			Expect(specifier.Compare(a, b)).To(Equal(expected))
+			Expect(specifier.Compare(b, a)).To(Equal(-expected))
+		},
+		Entry("equal versions", "1.2.3", "1.2.3", 0),
+		Entry("missing segments are zero", "1.2", "1.2.0", 0),
+		Entry("numeric rather than lexical ordering", "1.10.0", "1.9.0", 1),
+		Entry("a release is newer than its pre-release", "2.0.0", "2.0.0-rc.1", 1),
+		Entry("pre-releases compare numerically", "2.0.0-rc.10", "2.0.0-rc.2", 1),
+		Entry("a leading v is ignored", "v1.2.4", "1.2.3", 1),
+		Entry("unparseable versions are oldest", "0.0.1", "Spring 2017", 1),
+		Entry("unparseable versions compare as strings", "b", "a", 1),
+	)
+})
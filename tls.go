@@ -0,0 +1,88 @@
+package pivnet
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+)
+
+// TLSConfig adds to the default TLS settings of the client transport.
+type TLSConfig struct {
+	// CACertFiles and CACertPEM hold PEM encoded certificates trusted in
+	// addition to the system roots.
+	CACertFiles []string
+	CACertPEM   []byte
+
+	// ClientCertificates are presented to servers requesting mutual TLS.
+	ClientCertificates []ClientCertificate
+
+	// MinVersion is the minimum TLS version, such as tls.VersionTLS12.
+	MinVersion uint16
+}
+
+// ClientCertificate is a certificate and key pair, given either as files
+// or as PEM encoded bytes.
+type ClientCertificate struct {
+	CertFile string
+	KeyFile  string
+	CertPEM  []byte
+	KeyPEM   []byte
+}
+
+func (c ClientConfig) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: c.SkipSSLValidation,
+		MinVersion:         c.TLS.MinVersion,
+	}
+
+	if len(c.TLS.CACertFiles) > 0 || len(c.TLS.CACertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+
+		for _, path := range c.TLS.CACertFiles {
+			pem, err := ioutil.ReadFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("could not read CA certificates: %s", err)
+			}
+
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("no CA certificates found in '%s'", path)
+			}
+		}
+
+		if len(c.TLS.CACertPEM) > 0 && !pool.AppendCertsFromPEM(c.TLS.CACertPEM) {
+			return nil, fmt.Errorf("no CA certificates found in CACertPEM")
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	for _, clientCert := range c.TLS.ClientCertificates {
+		cert, err := clientCert.load()
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = append(tlsConfig.Certificates, cert)
+	}
+
+	return tlsConfig, nil
+}
+
+func (c ClientCertificate) load() (tls.Certificate, error) {
+	if c.CertFile != "" || c.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return tls.Certificate{}, fmt.Errorf("could not load client certificate: %s", err)
+		}
+		return cert, nil
+	}
+
+	cert, err := tls.X509KeyPair(c.CertPEM, c.KeyPEM)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("could not load client certificate: %s", err)
+	}
+	return cert, nil
+}
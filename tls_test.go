@@ -0,0 +1,145 @@
+package pivnet_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/logger/loggerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PivnetClient - TLS", func() {
+	var (
+		server *httptest.Server
+		caPEM  []byte
+	)
+
+	newServer := func(configure func(*tls.Config)) {
+		server = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"id":1,"slug":"some-product"}`))
+		}))
+		server.TLS = &tls.Config{}
+		configure(server.TLS)
+		server.StartTLS()
+
+		caPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	}
+
+	getProduct := func(tlsConfig pivnet.TLSConfig) error {
+		client := pivnet.NewClient(pivnet.ClientConfig{
+			Host:  server.URL,
+			Token: "my-auth-token",
+			TLS:   tlsConfig,
+		}, &loggerfakes.FakeLogger{})
+
+		_, err := client.Products.Get("some-product")
+		return err
+	}
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("root CAs", func() {
+		BeforeEach(func() {
+			newServer(func(*tls.Config) {})
+		})
+
+		It("rejects servers signed by an unknown CA", func() {
+			err := getProduct(pivnet.TLSConfig{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("certificate"))
+		})
+
+		It("trusts CAs given as PEM", func() {
+			Expect(getProduct(pivnet.TLSConfig{CACertPEM: caPEM})).To(Succeed())
+		})
+
+		It("trusts CAs given as files", func() {
+			dir, err := ioutil.TempDir("", "tls")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.RemoveAll(dir)
+
+			path := filepath.Join(dir, "ca.pem")
+			Expect(ioutil.WriteFile(path, caPEM, 0600)).To(Succeed())
+
+			Expect(getProduct(pivnet.TLSConfig{CACertFiles: []string{path}})).To(Succeed())
+		})
+
+		It("returns an error from every request when a CA file is invalid", func() {
+			err := getProduct(pivnet.TLSConfig{CACertFiles: []string{"/does/not/exist"}})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("could not read CA certificates"))
+		})
+	})
+
+	It("enforces the minimum TLS version", func() {
+		newServer(func(c *tls.Config) {
+			c.MaxVersion = tls.VersionTLS12
+		})
+
+		Expect(getProduct(pivnet.TLSConfig{CACertPEM: caPEM})).To(Succeed())
+		Expect(getProduct(pivnet.TLSConfig{CACertPEM: caPEM, MinVersion: tls.VersionTLS13})).NotTo(Succeed())
+	})
+
+	It("presents client certificates", func() {
+		certPEM, keyPEM := generateCertificate()
+
+		clientCAs := x509.NewCertPool()
+		Expect(clientCAs.AppendCertsFromPEM(certPEM)).To(BeTrue())
+
+		newServer(func(c *tls.Config) {
+			c.ClientAuth = tls.RequireAndVerifyClientCert
+			c.ClientCAs = clientCAs
+		})
+
+		Expect(getProduct(pivnet.TLSConfig{CACertPEM: caPEM})).NotTo(Succeed())
+		Expect(getProduct(pivnet.TLSConfig{
+			CACertPEM: caPEM,
+			ClientCertificates: []pivnet.ClientCertificate{
+				{CertPEM: certPEM, KeyPEM: keyPEM},
+			},
+		})).To(Succeed())
+	})
+})
+
+func generateCertificate() ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "go-pivnet"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		IsCA:         true,
+
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).NotTo(HaveOccurred())
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	Expect(err).NotTo(HaveOccurred())
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
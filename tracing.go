@@ -0,0 +1,20 @@
+package pivnet
+
+import (
+	"context"
+
+	"github.com/pivotal-cf/go-pivnet/tracing"
+)
+
+func (c Client) getTracer() tracing.Tracer {
+	if c.tracer == nil {
+		return tracing.Discard
+	}
+	return c.tracer
+}
+
+// startSpan starts a span for a service call as a child of any span in
+// ctx. Requests made with the returned context are children of the span.
+func (c Client) startSpan(ctx context.Context, name string, attributes ...tracing.Attribute) (context.Context, tracing.Span) {
+	return c.getTracer().Start(ctx, name, attributes...)
+}
@@ -0,0 +1,72 @@
+// Package otel provides a tracing.Tracer creating OpenTelemetry spans, so
+// they can be exported to Jaeger or any other OpenTelemetry backend.
+package otel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/pivotal-cf/go-pivnet/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracer is a tracing.Tracer adapting an OpenTelemetry tracer. Trace
+// context is propagated with the global text map propagator.
+type Tracer struct {
+	tracer trace.Tracer
+}
+
+func NewTracer(tracer trace.Tracer) Tracer {
+	return Tracer{tracer: tracer}
+}
+
+func (o Tracer) Start(ctx context.Context, name string, attributes ...tracing.Attribute) (context.Context, tracing.Span) {
+	ctx, span := o.tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(keyValues(attributes)...),
+	)
+	return ctx, otelSpan{span: span}
+}
+
+func (o Tracer) Inject(ctx context.Context, header http.Header) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+type otelSpan struct {
+	span trace.Span
+}
+
+func (s otelSpan) SetAttributes(attributes ...tracing.Attribute) {
+	s.span.SetAttributes(keyValues(attributes)...)
+}
+
+func (s otelSpan) RecordError(err error) {
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+func (s otelSpan) End() {
+	s.span.End()
+}
+
+func keyValues(attributes []tracing.Attribute) []attribute.KeyValue {
+	var kvs []attribute.KeyValue
+	for _, a := range attributes {
+		switch v := a.Value.(type) {
+		case string:
+			kvs = append(kvs, attribute.String(a.Key, v))
+		case int:
+			kvs = append(kvs, attribute.Int(a.Key, v))
+		case int64:
+			kvs = append(kvs, attribute.Int64(a.Key, v))
+		default:
+			kvs = append(kvs, attribute.String(a.Key, fmt.Sprint(v)))
+		}
+	}
+	return kvs
+}
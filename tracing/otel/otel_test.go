@@ -0,0 +1,106 @@
+package otel_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/pivotal-cf/go-pivnet/tracing"
+	pivnetotel "github.com/pivotal-cf/go-pivnet/tracing/otel"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tracer", func() {
+	var (
+		exporter *tracetest.InMemoryExporter
+		tracer   pivnetotel.Tracer
+	)
+
+	BeforeEach(func() {
+		exporter = tracetest.NewInMemoryExporter()
+		provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+		tracer = pivnetotel.NewTracer(provider.Tracer("go-pivnet"))
+	})
+
+	It("is a tracing.Tracer", func() {
+		var _ tracing.Tracer = tracer
+	})
+
+	It("creates client spans with attributes", func() {
+		_, span := tracer.Start(context.Background(), "Releases.Get",
+			tracing.String(tracing.ProductSlug, "some-product"),
+			tracing.Int(tracing.ReleaseID, 3),
+		)
+		span.SetAttributes(tracing.Int64(tracing.HTTPStatusCode, 200))
+		span.End()
+
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Name).To(Equal("Releases.Get"))
+		Expect(spans[0].SpanKind).To(Equal(trace.SpanKindClient))
+		Expect(spans[0].Attributes).To(ConsistOf(
+			attribute.String(tracing.ProductSlug, "some-product"),
+			attribute.Int(tracing.ReleaseID, 3),
+			attribute.Int64(tracing.HTTPStatusCode, 200),
+		))
+	})
+
+	It("starts spans as children of the span in the context", func() {
+		ctx, parent := tracer.Start(context.Background(), "parent")
+		_, child := tracer.Start(ctx, "child")
+		child.End()
+		parent.End()
+
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(2))
+		Expect(spans[0].Parent.SpanID()).To(Equal(spans[1].SpanContext.SpanID()))
+		Expect(spans[0].SpanContext.TraceID()).To(Equal(spans[1].SpanContext.TraceID()))
+	})
+
+	It("records errors as events and sets an error status", func() {
+		_, span := tracer.Start(context.Background(), "HTTP GET")
+		span.RecordError(errors.New("some error"))
+		span.End()
+
+		spans := exporter.GetSpans()
+		Expect(spans[0].Status.Code).To(Equal(codes.Error))
+		Expect(spans[0].Status.Description).To(Equal("some error"))
+		Expect(spans[0].Events).To(HaveLen(1))
+	})
+
+	Context("when the global propagator uses W3C trace context", func() {
+		var previous propagation.TextMapPropagator
+
+		BeforeEach(func() {
+			previous = otel.GetTextMapPropagator()
+			otel.SetTextMapPropagator(propagation.TraceContext{})
+		})
+
+		AfterEach(func() {
+			otel.SetTextMapPropagator(previous)
+		})
+
+		It("injects the traceparent header", func() {
+			ctx, span := tracer.Start(context.Background(), "HTTP GET")
+			span.End()
+
+			header := http.Header{}
+			tracer.Inject(ctx, header)
+
+			spanContext := exporter.GetSpans()[0].SpanContext
+			Expect(header.Get("traceparent")).To(Equal(
+				"00-" + spanContext.TraceID().String() + "-" + spanContext.SpanID().String() + "-01",
+			))
+		})
+	})
+})
@@ -0,0 +1,79 @@
+// Package tracing creates spans for service calls, API requests and
+// download ranges without depending on a tracing library.
+//
+// Set a Tracer as pivnet.ClientConfig.Tracer. The otel subpackage adapts
+// an OpenTelemetry tracer.
+package tracing
+
+import (
+	"context"
+	"net/http"
+)
+
+// Tracer starts spans and propagates trace context to outgoing requests.
+type Tracer interface {
+	// Start starts a span as a child of any span in ctx, and returns a
+	// context containing the new span.
+	Start(ctx context.Context, name string, attributes ...Attribute) (context.Context, Span)
+
+	// Inject adds the trace context in ctx to the headers of an
+	// outgoing request.
+	Inject(ctx context.Context, header http.Header)
+}
+
+type Span interface {
+	SetAttributes(attributes ...Attribute)
+	RecordError(err error)
+	End()
+}
+
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+func String(key string, value string) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+func Int(key string, value int) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+func Int64(key string, value int64) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+const (
+	ProductSlug   = "pivnet.product.slug"
+	ReleaseID     = "pivnet.release.id"
+	ProductFileID = "pivnet.product_file.id"
+	FileGroupID   = "pivnet.file_group.id"
+	UserGroupID   = "pivnet.user_group.id"
+	EULASlug      = "pivnet.eula.slug"
+
+	ByteRange  = "pivnet.download.byte_range"
+	RetryCount = "pivnet.download.retry_count"
+
+	HTTPMethod     = "http.method"
+	HTTPURL        = "http.url"
+	HTTPRoute      = "http.route"
+	HTTPStatusCode = "http.status_code"
+)
+
+// Discard is a Tracer that records nothing.
+var Discard Tracer = discard{}
+
+type discard struct{}
+
+func (discard) Start(ctx context.Context, name string, attributes ...Attribute) (context.Context, Span) {
+	return ctx, discardSpan{}
+}
+
+func (discard) Inject(context.Context, http.Header) {}
+
+type discardSpan struct{}
+
+func (discardSpan) SetAttributes(...Attribute) {}
+func (discardSpan) RecordError(error)          {}
+func (discardSpan) End()                       {}
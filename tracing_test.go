@@ -0,0 +1,207 @@
+package pivnet_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/logger/loggerfakes"
+	"github.com/pivotal-cf/go-pivnet/pivnettest"
+	"github.com/pivotal-cf/go-pivnet/tracing"
+	pivnetotel "github.com/pivotal-cf/go-pivnet/tracing/otel"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PivnetClient - tracing", func() {
+	var (
+		server     *pivnettest.Server
+		exporter   *tracetest.InMemoryExporter
+		propagator propagation.TextMapPropagator
+		tracer     pivnetotel.Tracer
+		client     pivnet.Client
+
+		mutex        sync.Mutex
+		traceparents map[string]string
+	)
+
+	named := func(name string) tracetest.SpanStubs {
+		var spans tracetest.SpanStubs
+		for _, span := range exporter.GetSpans() {
+			if span.Name == name {
+				spans = append(spans, span)
+			}
+		}
+		return spans
+	}
+
+	traceparent := func(span tracetest.SpanStub) string {
+		return fmt.Sprintf("00-%s-%s-01", span.SpanContext.TraceID(), span.SpanContext.SpanID())
+	}
+
+	BeforeEach(func() {
+		server = pivnettest.NewServer()
+		Expect(server.Seed(pivnettest.Fixture{
+			Products: []pivnettest.Product{{
+				ID:   1,
+				Slug: "some-product",
+				ProductFiles: []pivnettest.ProductFile{{
+					ProductFile: pivnet.ProductFile{ID: 2, AWSObjectKey: "some-file"},
+					Contents:    "some contents",
+				}},
+				Releases: []pivnettest.Release{{
+					Release:        pivnet.Release{ID: 3, Version: "1.0.0"},
+					ProductFileIDs: []int{2},
+				}},
+			}},
+		})).To(Succeed())
+
+		exporter = tracetest.NewInMemoryExporter()
+		provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+		tracer = pivnetotel.NewTracer(provider.Tracer("go-pivnet"))
+
+		propagator = otel.GetTextMapPropagator()
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+
+		traceparents = map[string]string{}
+
+		capture := func(next http.RoundTripper) http.RoundTripper {
+			return pivnet.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				mutex.Lock()
+				traceparents[req.Method+" "+req.URL.Path] = req.Header.Get("traceparent")
+				mutex.Unlock()
+				return next.RoundTrip(req)
+			})
+		}
+
+		client = pivnet.NewClient(pivnet.ClientConfig{
+			Host:       server.URL(),
+			Token:      "my-auth-token",
+			Tracer:     tracer,
+			Middleware: []pivnet.Middleware{capture},
+		}, &loggerfakes.FakeLogger{})
+	})
+
+	AfterEach(func() {
+		otel.SetTextMapPropagator(propagator)
+		server.Close()
+	})
+
+	It("creates a span for each service call with a child span for each request", func() {
+		_, err := client.Releases.Get("some-product", 3)
+		Expect(err).NotTo(HaveOccurred())
+
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(2))
+
+		request, call := spans[0], spans[1]
+		Expect(call.Name).To(Equal("Releases.Get"))
+		Expect(call.Parent.IsValid()).To(BeFalse())
+		Expect(call.Attributes).To(ConsistOf(
+			attribute.String(tracing.ProductSlug, "some-product"),
+			attribute.Int(tracing.ReleaseID, 3),
+		))
+
+		Expect(request.Name).To(Equal("HTTP GET"))
+		Expect(request.SpanContext.TraceID()).To(Equal(call.SpanContext.TraceID()))
+		Expect(request.Parent.SpanID()).To(Equal(call.SpanContext.SpanID()))
+		Expect(request.Attributes).To(ContainElement(
+			attribute.String(tracing.HTTPRoute, "/products/:product_slug/releases/:id"),
+		))
+		Expect(request.Attributes).To(ContainElement(attribute.Int(tracing.HTTPStatusCode, 200)))
+	})
+
+	It("creates service call spans as children of the span in the given context", func() {
+		ctx, parent := tracer.Start(context.Background(), "deploy")
+		_, err := client.Releases.GetWithContext(ctx, "some-product", 3)
+		Expect(err).NotTo(HaveOccurred())
+		parent.End()
+
+		deploy := named("deploy")[0]
+		call := named("Releases.Get")[0]
+		Expect(call.Parent.SpanID()).To(Equal(deploy.SpanContext.SpanID()))
+		Expect(call.SpanContext.TraceID()).To(Equal(deploy.SpanContext.TraceID()))
+	})
+
+	It("cancels requests when the given context is done", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := client.Releases.GetWithContext(ctx, "some-product", 3)
+		Expect(err).To(MatchError(ContainSubstring(context.Canceled.Error())))
+
+		request := named("HTTP GET")[0]
+		Expect(request.Status.Code).To(Equal(codes.Error))
+	})
+
+	It("propagates trace context to outgoing requests", func() {
+		_, err := client.Products.Get("some-product")
+		Expect(err).NotTo(HaveOccurred())
+
+		request := named("HTTP GET")[0]
+		Expect(traceparents).To(HaveKeyWithValue(
+			"GET /api/v2/products/some-product",
+			traceparent(request),
+		))
+	})
+
+	It("records errors on request spans", func() {
+		_, err := client.Releases.Get("some-product", 404)
+		Expect(err).To(HaveOccurred())
+
+		request := named("HTTP GET")[0]
+		Expect(request.Attributes).To(ContainElement(attribute.Int(tracing.HTTPStatusCode, http.StatusNotFound)))
+		Expect(request.Status.Code).To(Equal(codes.Error))
+		Expect(request.Events).To(HaveLen(1))
+	})
+
+	It("nests service calls made by other service calls", func() {
+		_, _, err := client.Releases.Ensure(pivnet.EnsureReleaseConfig{
+			CreateReleaseConfig: pivnet.CreateReleaseConfig{
+				ProductSlug: "some-product",
+				Version:     "1.0.0",
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		ensure := named("Releases.Ensure")[0]
+		list := named("Releases.List")
+		Expect(list).To(HaveLen(1))
+		Expect(list[0].Parent.SpanID()).To(Equal(ensure.SpanContext.SpanID()))
+	})
+
+	It("creates a span for each download range", func() {
+		location, err := ioutil.TempFile("", "tracing")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.Remove(location.Name())
+		defer location.Close()
+
+		err = client.ProductFiles.DownloadForRelease(location, "some-product", 3, 2, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		download := named("ProductFiles.DownloadForRelease")[0]
+		Expect(download.Attributes).To(ContainElement(attribute.Int(tracing.ProductFileID, 2)))
+
+		ranges := named("download range")
+		Expect(ranges).NotTo(BeEmpty())
+		for _, r := range ranges {
+			Expect(r.Parent.SpanID()).To(Equal(download.SpanContext.SpanID()))
+			attributes := attribute.NewSet(r.Attributes...)
+			Expect(attributes.HasValue(tracing.ByteRange)).To(BeTrue())
+			Expect(r.Attributes).To(ContainElement(attribute.Int(tracing.RetryCount, 0)))
+		}
+
+		Expect(traceparents["GET /files/some-product/2"]).To(ContainSubstring(download.SpanContext.TraceID().String()))
+	})
+})
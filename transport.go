@@ -0,0 +1,68 @@
+package pivnet
+
+import "net/http"
+
+// Middleware wraps a transport, for example to add authentication,
+// retries, logging, metrics or caching.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// RoundTripperFunc adapts a function to an http.RoundTripper.
+type RoundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Chain wraps transport in middleware. The first middleware is the
+// outermost, so it sees each request first and each response last.
+func Chain(transport http.RoundTripper, middleware ...Middleware) http.RoundTripper {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		transport = middleware[i](transport)
+	}
+	return transport
+}
+
+// transport builds the transport shared by API requests and product
+// file downloads. Failover is beneath the middleware, so middleware such
+// as authentication sees requests addressed to the primary host.
+func (c ClientConfig) transport() http.RoundTripper {
+	transport := c.baseTransport()
+	if len(c.Hosts) > 1 {
+		transport = newFailoverTransport(c.Hosts, c.Failover, transport)
+	}
+
+	return Chain(transport, c.Middleware...)
+}
+
+// baseTransport returns Transport, or the default transport. Configuration
+// errors are returned by every request, as NewClient cannot fail.
+func (c ClientConfig) baseTransport() http.RoundTripper {
+	if c.Transport != nil {
+		return c.Transport
+	}
+
+	transport, err := c.defaultTransport()
+	if err != nil {
+		return RoundTripperFunc(func(*http.Request) (*http.Response, error) {
+			return nil, err
+		})
+	}
+	return transport
+}
+
+func (c ClientConfig) defaultTransport() (http.RoundTripper, error) {
+	tlsConfig, err := c.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	proxy, err := c.proxy()
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Transport{
+		TLSClientConfig: tlsConfig,
+		Proxy:           proxy,
+	}, nil
+}
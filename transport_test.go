@@ -0,0 +1,108 @@
+package pivnet_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/onsi/gomega/ghttp"
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/logger/loggerfakes"
+	"github.com/pivotal-cf/go-pivnet/pivnettest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PivnetClient - transport", func() {
+	var (
+		mutex sync.Mutex
+		calls []string
+	)
+
+	recording := func(name string) pivnet.Middleware {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return pivnet.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				mutex.Lock()
+				calls = append(calls, name+" "+req.Method+" "+req.URL.Path)
+				mutex.Unlock()
+				return next.RoundTrip(req)
+			})
+		}
+	}
+
+	BeforeEach(func() {
+		calls = nil
+	})
+
+	It("wraps the transport in middleware, outermost first", func() {
+		server := ghttp.NewServer()
+		defer server.Close()
+		server.RouteToHandler("GET", apiPrefix+"/products/some-product",
+			ghttp.RespondWith(http.StatusOK, `{"id":1,"slug":"some-product"}`))
+
+		var sent []string
+		transport := pivnet.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			sent = append(sent, req.Header.Get("X-Middleware"))
+			return http.DefaultTransport.RoundTrip(req)
+		})
+
+		header := func(value string) pivnet.Middleware {
+			return func(next http.RoundTripper) http.RoundTripper {
+				return pivnet.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					req.Header.Set("X-Middleware", req.Header.Get("X-Middleware")+value)
+					return next.RoundTrip(req)
+				})
+			}
+		}
+
+		client := pivnet.NewClient(pivnet.ClientConfig{
+			Host:       server.URL(),
+			Token:      "my-auth-token",
+			Transport:  transport,
+			Middleware: []pivnet.Middleware{header("a"), header("b")},
+		}, &loggerfakes.FakeLogger{})
+
+		_, err := client.Products.Get("some-product")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sent).To(Equal([]string{"ab"}))
+	})
+
+	It("uses the same middleware for downloads", func() {
+		server := pivnettest.NewServer()
+		defer server.Close()
+
+		Expect(server.Seed(pivnettest.Fixture{
+			Products: []pivnettest.Product{{
+				ID:   1,
+				Slug: "some-product",
+				ProductFiles: []pivnettest.ProductFile{{
+					ProductFile: pivnet.ProductFile{ID: 2, AWSObjectKey: "some-file"},
+					Contents:    "some contents",
+				}},
+				Releases: []pivnettest.Release{{
+					Release:        pivnet.Release{ID: 3, Version: "1.0.0"},
+					ProductFileIDs: []int{2},
+				}},
+			}},
+		})).To(Succeed())
+
+		client := pivnet.NewClient(pivnet.ClientConfig{
+			Host:       server.URL(),
+			Token:      "my-auth-token",
+			Middleware: []pivnet.Middleware{recording("outer")},
+		}, &loggerfakes.FakeLogger{})
+
+		location, err := ioutil.TempFile("", "transport")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.Remove(location.Name())
+		defer location.Close()
+
+		err = client.ProductFiles.DownloadForRelease(location, "some-product", 3, 2, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(calls).To(ContainElement("outer HEAD /redirect/some-product/2"))
+		Expect(calls).To(ContainElement("outer GET /files/some-product/2"))
+	})
+})
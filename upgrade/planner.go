@@ -0,0 +1,359 @@
+// Package upgrade plans the sequence of releases needed to upgrade a
+// product from one version to another.
+package upgrade
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/logger"
+	"github.com/pivotal-cf/go-pivnet/specifier"
+)
+
+const dateFormat = "2006-01-02"
+
+type Planner struct {
+	client pivnet.Client
+	logger logger.Logger
+}
+
+type Options struct {
+	// PreferSupported chooses, among the shortest paths, the one passing
+	// through the fewest releases past their end of support date.
+	PreferSupported bool
+
+	// SupportedOn is the date support is checked against.
+	// It defaults to today.
+	SupportedOn time.Time
+}
+
+// Path is the sequence of releases to install, starting with the
+// installed release and ending with the target release.
+type Path struct {
+	Releases []pivnet.Release `json:"releases" yaml:"releases"`
+}
+
+func (p Path) Versions() []string {
+	versions := make([]string, len(p.Releases))
+	for i, release := range p.Releases {
+		versions[i] = release.Version
+	}
+	return versions
+}
+
+// ErrUnreachable explains why no upgrade path exists.
+type ErrUnreachable struct {
+	From   string `json:"from" yaml:"from"`
+	To     string `json:"to" yaml:"to"`
+	Reason string `json:"reason" yaml:"reason"`
+}
+
+func (e ErrUnreachable) Error() string {
+	return fmt.Sprintf("cannot upgrade from %s to %s: %s", e.From, e.To, e.Reason)
+}
+
+func NewPlanner(client pivnet.Client, logger logger.Logger) Planner {
+	return Planner{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Plan returns the shortest sequence of upgrades from the installed
+// version to the target version, using both explicit upgrade paths and
+// upgrade path specifiers.
+func (p Planner) Plan(productSlug string, installedVersion string, targetVersion string, options Options) (Path, error) {
+	unreachable := func(format string, args ...interface{}) error {
+		return ErrUnreachable{
+			From:   installedVersion,
+			To:     targetVersion,
+			Reason: fmt.Sprintf(format, args...),
+		}
+	}
+
+	releases, err := p.client.Releases.List(productSlug)
+	if err != nil {
+		return Path{}, err
+	}
+
+	byVersion := map[string]pivnet.Release{}
+	for _, release := range releases {
+		byVersion[release.Version] = release
+	}
+
+	installed, ok := byVersion[installedVersion]
+	if !ok {
+		return Path{}, unreachable("installed version %s is not a release of '%s'", installedVersion, productSlug)
+	}
+
+	target, ok := byVersion[targetVersion]
+	if !ok {
+		return Path{}, unreachable("target version %s is not a release of '%s'", targetVersion, productSlug)
+	}
+
+	if installedVersion == targetVersion {
+		return Path{Releases: []pivnet.Release{installed}}, nil
+	}
+
+	if specifier.Compare(installedVersion, targetVersion) > 0 {
+		return Path{}, unreachable("target version is older than the installed version")
+	}
+
+	// Only releases newer than the installed version and no newer than
+	// the target can be part of the path.
+	var candidates []pivnet.Release
+	for _, release := range releases {
+		if specifier.Compare(release.Version, installedVersion) > 0 &&
+			specifier.Compare(release.Version, targetVersion) <= 0 {
+			candidates = append(candidates, release)
+		}
+	}
+
+	g, err := p.buildGraph(productSlug, installed, candidates)
+	if err != nil {
+		return Path{}, err
+	}
+
+	supportedOn := options.SupportedOn
+	if supportedOn.IsZero() {
+		supportedOn = time.Now()
+	}
+
+	path := g.shortestPath(installed.ID, target.ID, func(release pivnet.Release) int {
+		if options.PreferSupported && !supported(release, supportedOn) {
+			return 1
+		}
+		return 0
+	})
+
+	if path == nil {
+		return Path{}, unreachable("%s", g.explain(installed, target))
+	}
+
+	return Path{Releases: path}, nil
+}
+
+func (p Planner) buildGraph(productSlug string, installed pivnet.Release, candidates []pivnet.Release) (graph, error) {
+	g := graph{
+		releases: map[int]pivnet.Release{installed.ID: installed},
+		next:     map[int][]int{},
+		previous: map[int][]int{},
+	}
+
+	sources := append([]pivnet.Release{installed}, candidates...)
+
+	for _, release := range candidates {
+		g.releases[release.ID] = release
+
+		upgradePaths, err := p.client.ReleaseUpgradePaths.Get(productSlug, release.ID)
+		if err != nil {
+			return graph{}, err
+		}
+
+		for _, upgradePath := range upgradePaths {
+			g.addEdge(upgradePath.Release.ID, release.ID)
+		}
+
+		upgradePathSpecifiers, err := p.client.UpgradePathSpecifiers.List(productSlug, release.ID)
+		if err != nil {
+			return graph{}, err
+		}
+
+		for _, upgradePathSpecifier := range upgradePathSpecifiers {
+			matching, err := pivnet.ReleasesMatchingSpecifier(upgradePathSpecifier.Specifier, sources)
+			if err != nil {
+				p.logger.Info("Ignoring invalid upgrade path specifier", logger.Data{
+					"release":   release.Version,
+					"specifier": upgradePathSpecifier.Specifier,
+					"error":     err.Error(),
+				})
+				continue
+			}
+
+			for _, from := range matching {
+				g.addEdge(from.ID, release.ID)
+			}
+		}
+	}
+
+	return g, nil
+}
+
+func supported(release pivnet.Release, on time.Time) bool {
+	if release.EndOfSupportDate == "" {
+		return true
+	}
+
+	endOfSupport, err := time.Parse(dateFormat, release.EndOfSupportDate)
+	if err != nil {
+		return true
+	}
+
+	// A release is supported until the end of its end of support date
+	return on.Before(endOfSupport.AddDate(0, 0, 1))
+}
+
+// graph has an edge from each release to the releases it can be
+// upgraded to directly.
+type graph struct {
+	releases map[int]pivnet.Release
+	next     map[int][]int
+	previous map[int][]int
+}
+
+func (g *graph) addEdge(from int, to int) {
+	if from == to {
+		return
+	}
+	if _, ok := g.releases[from]; !ok {
+		return
+	}
+
+	for _, existing := range g.next[from] {
+		if existing == to {
+			return
+		}
+	}
+
+	g.next[from] = append(g.next[from], to)
+	g.previous[to] = append(g.previous[to], from)
+}
+
+// shortestPath returns the path with the fewest upgrades, breaking ties
+// by the lowest total penalty and then by preferring newer releases.
+func (g graph) shortestPath(from int, to int, penalty func(pivnet.Release) int) []pivnet.Release {
+	type cost struct {
+		hops    int
+		penalty int
+	}
+
+	less := func(a cost, b cost) bool {
+		if a.hops != b.hops {
+			return a.hops < b.hops
+		}
+		return a.penalty < b.penalty
+	}
+
+	costs := map[int]cost{from: {}}
+	via := map[int]int{}
+	done := map[int]bool{}
+
+	for {
+		current, found := 0, false
+		for id, c := range costs {
+			if done[id] {
+				continue
+			}
+			if !found || less(c, costs[current]) ||
+				(!less(costs[current], c) && g.newer(id, current)) {
+				current, found = id, true
+			}
+		}
+
+		if !found {
+			return nil
+		}
+
+		if current == to {
+			break
+		}
+		done[current] = true
+
+		for _, next := range g.sortedNext(current) {
+			c := cost{
+				hops:    costs[current].hops + 1,
+				penalty: costs[current].penalty + penalty(g.releases[next]),
+			}
+
+			existing, seen := costs[next]
+			if !seen || less(c, existing) {
+				costs[next] = c
+				via[next] = current
+			}
+		}
+	}
+
+	var path []pivnet.Release
+	for id := to; ; id = via[id] {
+		path = append([]pivnet.Release{g.releases[id]}, path...)
+		if id == from {
+			break
+		}
+	}
+
+	return path
+}
+
+// sortedNext returns the releases reachable directly from id, newest first.
+func (g graph) sortedNext(id int) []int {
+	next := append([]int{}, g.next[id]...)
+	sort.SliceStable(next, func(i, j int) bool {
+		return g.newer(next[i], next[j])
+	})
+	return next
+}
+
+func (g graph) newer(a int, b int) bool {
+	return specifier.Compare(g.releases[a].Version, g.releases[b].Version) > 0
+}
+
+func (g graph) explain(installed pivnet.Release, target pivnet.Release) string {
+	reachable := g.walk(installed.ID, g.next)
+	leadsToTarget := g.walk(target.ID, g.previous)
+
+	switch {
+	case len(reachable) == 0:
+		return fmt.Sprintf("no release can be upgraded to from %s", installed.Version)
+	case len(leadsToTarget) == 0:
+		return fmt.Sprintf("no release can be upgraded to %s", target.Version)
+	default:
+		return fmt.Sprintf(
+			"releases reachable from %s are [%s] but %s can only be reached from [%s]",
+			installed.Version,
+			strings.Join(g.versions(reachable), ", "),
+			target.Version,
+			strings.Join(g.versions(leadsToTarget), ", "),
+		)
+	}
+}
+
+// walk returns the IDs of every release reachable from start by
+// following edges, excluding start itself.
+func (g graph) walk(start int, edges map[int][]int) []int {
+	seen := map[int]bool{start: true}
+	queue := []int{start}
+
+	var found []int
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		for _, next := range edges[id] {
+			if seen[next] {
+				continue
+			}
+			seen[next] = true
+			found = append(found, next)
+			queue = append(queue, next)
+		}
+	}
+
+	return found
+}
+
+// versions returns the versions of the given releases, oldest first.
+func (g graph) versions(ids []int) []string {
+	versions := make([]string, len(ids))
+	for i, id := range ids {
+		versions[i] = g.releases[id].Version
+	}
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		return specifier.Compare(versions[i], versions[j]) < 0
+	})
+
+	return versions
+}
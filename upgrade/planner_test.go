@@ -0,0 +1,168 @@
+package upgrade_test
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/onsi/gomega/ghttp"
+	"github.com/pivotal-cf/go-pivnet"
+	"github.com/pivotal-cf/go-pivnet/logger/loggerfakes"
+	"github.com/pivotal-cf/go-pivnet/upgrade"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Planner", func() {
+	var (
+		server     *ghttp.Server
+		fakeLogger *loggerfakes.FakeLogger
+		planner    upgrade.Planner
+		options    upgrade.Options
+	)
+
+	productSlug := "some-product"
+
+	releaseURL := func(releaseID int, suffix string) string {
+		return fmt.Sprintf("%s/products/%s/releases/%d%s", apiPrefix, productSlug, releaseID, suffix)
+	}
+
+	routeRelease := func(releaseID int, upgradePaths string, specifiers string) {
+		server.RouteToHandler("GET", releaseURL(releaseID, "/upgrade_paths"),
+			ghttp.RespondWith(http.StatusOK, fmt.Sprintf(`{"upgrade_paths":[%s]}`, upgradePaths)))
+		server.RouteToHandler("GET", releaseURL(releaseID, "/upgrade_path_specifiers"),
+			ghttp.RespondWith(http.StatusOK, fmt.Sprintf(`{"upgrade_path_specifiers":[%s]}`, specifiers)))
+	}
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+
+		fakeLogger = &loggerfakes.FakeLogger{}
+		client := pivnet.NewClient(pivnet.ClientConfig{
+			Host:      server.URL(),
+			Token:     "my-auth-token",
+			UserAgent: "pivnet-resource/0.1.0 (some-url)",
+		}, fakeLogger)
+
+		planner = upgrade.NewPlanner(client, fakeLogger)
+		options = upgrade.Options{
+			SupportedOn: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+		}
+
+		server.RouteToHandler("GET", fmt.Sprintf("%s/products/%s/releases", apiPrefix, productSlug),
+			ghttp.RespondWith(http.StatusOK, `{"releases":[
+				{"id":1,"version":"1.0.0"},
+				{"id":2,"version":"1.1.0"},
+				{"id":3,"version":"1.2.0","end_of_support_date":"2021-06-01"},
+				{"id":4,"version":"1.3.0","end_of_support_date":"2021-05-31"},
+				{"id":5,"version":"2.0.0"},
+				{"id":7,"version":"2.5.0"},
+				{"id":6,"version":"3.0.0"}
+			]}`))
+
+		routeRelease(2, `{"release":{"id":1,"version":"1.0.0"}}`, `{"id":1,"specifier":"~> 1"}`)
+		routeRelease(3, "", `{"id":2,"specifier":"~> 1.0"}`)
+		routeRelease(4, `{"release":{"id":1,"version":"1.0.0"}}`, "")
+		routeRelease(5, "", `{"id":3,"specifier":"1.2.*"},{"id":4,"specifier":"1.3.*"}`)
+		routeRelease(7, "", "")
+		routeRelease(6, `{"release":{"id":7,"version":"2.5.0"}}`, "")
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("returns the shortest path, preferring newer releases", func() {
+		path, err := planner.Plan(productSlug, "1.0.0", "2.0.0", options)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(path.Versions()).To(Equal([]string{"1.0.0", "1.3.0", "2.0.0"}))
+		Expect(path.Releases[1].ID).To(Equal(4))
+	})
+
+	It("uses explicit upgrade paths and specifiers", func() {
+		path, err := planner.Plan(productSlug, "1.1.0", "2.0.0", options)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(path.Versions()).To(Equal([]string{"1.1.0", "1.2.0", "2.0.0"}))
+	})
+
+	It("logs and ignores invalid specifiers", func() {
+		_, err := planner.Plan(productSlug, "1.0.0", "1.1.0", options)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeLogger.InfoCallCount()).To(BeNumerically(">", 0))
+	})
+
+	Context("when preferring supported releases", func() {
+		BeforeEach(func() {
+			options.PreferSupported = true
+		})
+
+		It("avoids releases past their end of support date", func() {
+			path, err := planner.Plan(productSlug, "1.0.0", "2.0.0", options)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(path.Versions()).To(Equal([]string{"1.0.0", "1.2.0", "2.0.0"}))
+		})
+	})
+
+	Context("when the installed version is the target", func() {
+		It("returns a path of just that release", func() {
+			path, err := planner.Plan(productSlug, "2.0.0", "2.0.0", options)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(path.Versions()).To(Equal([]string{"2.0.0"}))
+		})
+	})
+
+	Context("when the target cannot be reached", func() {
+		It("explains where each end of the path can reach", func() {
+			_, err := planner.Plan(productSlug, "1.0.0", "3.0.0", options)
+			Expect(err).To(HaveOccurred())
+
+			unreachable, ok := err.(upgrade.ErrUnreachable)
+			Expect(ok).To(BeTrue())
+			Expect(unreachable.From).To(Equal("1.0.0"))
+			Expect(unreachable.To).To(Equal("3.0.0"))
+			Expect(err.Error()).To(Equal(
+				"cannot upgrade from 1.0.0 to 3.0.0: releases reachable from 1.0.0 are [1.1.0, 1.2.0, 1.3.0, 2.0.0] but 3.0.0 can only be reached from [2.5.0]",
+			))
+		})
+
+		It("explains when nothing leads to the target", func() {
+			_, err := planner.Plan(productSlug, "1.0.0", "2.5.0", options)
+			Expect(err).To(MatchError(
+				"cannot upgrade from 1.0.0 to 2.5.0: no release can be upgraded to 2.5.0",
+			))
+		})
+
+		It("explains when the target is older than the installed version", func() {
+			_, err := planner.Plan(productSlug, "2.0.0", "1.0.0", options)
+			Expect(err).To(MatchError(
+				"cannot upgrade from 2.0.0 to 1.0.0: target version is older than the installed version",
+			))
+		})
+
+		It("explains when a version is not a release", func() {
+			_, err := planner.Plan(productSlug, "0.9.0", "2.0.0", options)
+			Expect(err).To(MatchError(
+				"cannot upgrade from 0.9.0 to 2.0.0: installed version 0.9.0 is not a release of 'some-product'",
+			))
+		})
+	})
+
+	Context("when listing releases fails", func() {
+		BeforeEach(func() {
+			server.RouteToHandler("GET", fmt.Sprintf("%s/products/%s/releases", apiPrefix, productSlug),
+				ghttp.RespondWith(http.StatusTeapot, `{"message":"foo message"}`))
+		})
+
+		It("returns the error", func() {
+			_, err := planner.Plan(productSlug, "1.0.0", "2.0.0", options)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("foo message"))
+		})
+	})
+})
@@ -2,9 +2,12 @@ package pivnet
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+
+	"github.com/pivotal-cf/go-pivnet/tracing"
 )
 
 type UpgradePathSpecifiersService struct {
@@ -25,6 +28,16 @@ type UpgradePathSpecifier struct {
 }
 
 func (r UpgradePathSpecifiersService) List(productSlug string, releaseID int) ([]UpgradePathSpecifier, error) {
+	return r.ListWithContext(context.Background(), productSlug, releaseID)
+}
+
+func (r UpgradePathSpecifiersService) ListWithContext(ctx context.Context, productSlug string, releaseID int) ([]UpgradePathSpecifier, error) {
+	ctx, span := r.client.startSpan(ctx, "UpgradePathSpecifiers.List",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/upgrade_path_specifiers",
 		productSlug,
@@ -32,7 +45,8 @@ func (r UpgradePathSpecifiersService) List(productSlug string, releaseID int) ([
 	)
 
 	var response UpgradePathSpecifiersResponse
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestWithContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -52,6 +66,16 @@ func (r UpgradePathSpecifiersService) List(productSlug string, releaseID int) ([
 }
 
 func (r UpgradePathSpecifiersService) Get(productSlug string, releaseID int, upgradePathSpecifierID int) (UpgradePathSpecifier, error) {
+	return r.GetWithContext(context.Background(), productSlug, releaseID, upgradePathSpecifierID)
+}
+
+func (r UpgradePathSpecifiersService) GetWithContext(ctx context.Context, productSlug string, releaseID int, upgradePathSpecifierID int) (UpgradePathSpecifier, error) {
+	ctx, span := r.client.startSpan(ctx, "UpgradePathSpecifiers.Get",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/upgrade_path_specifiers/%d",
 		productSlug,
@@ -59,7 +83,8 @@ func (r UpgradePathSpecifiersService) Get(productSlug string, releaseID int, upg
 		upgradePathSpecifierID,
 	)
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestWithContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -80,6 +105,16 @@ func (r UpgradePathSpecifiersService) Get(productSlug string, releaseID int, upg
 }
 
 func (r UpgradePathSpecifiersService) Create(productSlug string, releaseID int, specifier string) (UpgradePathSpecifier, error) {
+	return r.CreateWithContext(context.Background(), productSlug, releaseID, specifier)
+}
+
+func (r UpgradePathSpecifiersService) CreateWithContext(ctx context.Context, productSlug string, releaseID int, specifier string) (UpgradePathSpecifier, error) {
+	ctx, span := r.client.startSpan(ctx, "UpgradePathSpecifiers.Create",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/upgrade_path_specifiers",
 		productSlug,
@@ -99,7 +134,8 @@ func (r UpgradePathSpecifiersService) Create(productSlug string, releaseID int,
 		return UpgradePathSpecifier{}, err
 	}
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestWithContext(
+		ctx,
 		"POST",
 		url,
 		http.StatusCreated,
@@ -124,6 +160,21 @@ func (r UpgradePathSpecifiersService) Delete(
 	releaseID int,
 	upgradePathSpecifierID int,
 ) error {
+	return r.DeleteWithContext(context.Background(), productSlug, releaseID, upgradePathSpecifierID)
+}
+
+func (r UpgradePathSpecifiersService) DeleteWithContext(
+	ctx context.Context,
+	productSlug string,
+	releaseID int,
+	upgradePathSpecifierID int,
+) error {
+	ctx, span := r.client.startSpan(ctx, "UpgradePathSpecifiers.Delete",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/upgrade_path_specifiers/%d",
 		productSlug,
@@ -131,7 +182,8 @@ func (r UpgradePathSpecifiersService) Delete(
 		upgradePathSpecifierID,
 	)
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestWithContext(
+		ctx,
 		"DELETE",
 		url,
 		http.StatusNoContent,
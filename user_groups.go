@@ -2,9 +2,13 @@ package pivnet
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+
+	"github.com/pivotal-cf/go-pivnet/tracing"
 )
 
 type UserGroupsService struct {
@@ -31,6 +35,12 @@ type UserGroupsResponse struct {
 	UserGroups []UserGroup `json:"user_groups,omitempty"`
 }
 
+// userGroupsPage decodes a UserGroupsResponse and the link to its next page.
+type userGroupsPage struct {
+	UserGroupsResponse
+	Links *Links `json:"_links,omitempty"`
+}
+
 type UpdateUserGroupResponse struct {
 	UserGroup UserGroup `json:"user_group,omitempty"`
 }
@@ -62,56 +72,95 @@ type member struct {
 }
 
 func (u UserGroupsService) List() ([]UserGroup, error) {
-	url := "/user_groups"
+	return u.ListWithContext(context.Background())
+}
 
-	var response UserGroupsResponse
-	resp, err := u.client.MakeRequest(
-		"GET",
-		url,
-		http.StatusOK,
-		nil,
-	)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+func (u UserGroupsService) ListWithContext(ctx context.Context) ([]UserGroup, error) {
+	ctx, span := u.client.startSpan(ctx, "UserGroups.List")
+	defer span.End()
 
-	err = json.NewDecoder(resp.Body).Decode(&response)
+	var userGroups []UserGroup
+	err := u.ListIterWithContext(ctx, func(page []UserGroup) error {
+		userGroups = append(userGroups, page...)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return response.UserGroups, nil
+	return userGroups, nil
+}
+
+// ListIter calls fn with each page of user groups, following next links.
+// An error returned by fn stops the listing and is returned by ListIter.
+func (u UserGroupsService) ListIter(fn func(page []UserGroup) error) error {
+	return u.ListIterWithContext(context.Background(), fn)
+}
+
+func (u UserGroupsService) ListIterWithContext(ctx context.Context, fn func(page []UserGroup) error) error {
+	ctx, span := u.client.startSpan(ctx, "UserGroups.ListIter")
+	defer span.End()
+
+	url := "/user_groups"
+
+	return u.client.listPages(ctx, url, func(body io.Reader) (*Links, error) {
+		var response userGroupsPage
+		err := json.NewDecoder(body).Decode(&response)
+		if err != nil {
+			return nil, err
+		}
+
+		return response.Links, fn(response.UserGroups)
+	})
 }
 
 func (u UserGroupsService) ListForRelease(productSlug string, releaseID int) ([]UserGroup, error) {
+	return u.ListForReleaseWithContext(context.Background(), productSlug, releaseID)
+}
+
+func (u UserGroupsService) ListForReleaseWithContext(ctx context.Context, productSlug string, releaseID int) ([]UserGroup, error) {
+	ctx, span := u.client.startSpan(ctx, "UserGroups.ListForRelease",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/user_groups",
 		productSlug,
 		releaseID,
 	)
 
-	var response UserGroupsResponse
-	resp, err := u.client.MakeRequest(
-		"GET",
-		url,
-		http.StatusOK,
-		nil,
-	)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	err = json.NewDecoder(resp.Body).Decode(&response)
+	var userGroups []UserGroup
+	err := u.client.listPages(ctx, url, func(body io.Reader) (*Links, error) {
+		var response userGroupsPage
+		err := json.NewDecoder(body).Decode(&response)
+		if err != nil {
+			return nil, err
+		}
+
+		userGroups = append(userGroups, response.UserGroups...)
+		return response.Links, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return response.UserGroups, nil
+	return userGroups, nil
 }
 
 func (u UserGroupsService) AddToRelease(productSlug string, releaseID int, userGroupID int) error {
+	return u.AddToReleaseWithContext(context.Background(), productSlug, releaseID, userGroupID)
+}
+
+func (u UserGroupsService) AddToReleaseWithContext(ctx context.Context, productSlug string, releaseID int, userGroupID int) error {
+	ctx, span := u.client.startSpan(ctx, "UserGroups.AddToRelease",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+		tracing.Int(tracing.UserGroupID, userGroupID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/add_user_group",
 		productSlug,
@@ -131,7 +180,8 @@ func (u UserGroupsService) AddToRelease(productSlug string, releaseID int, userG
 		return err
 	}
 
-	resp, err := u.client.MakeRequest(
+	resp, err := u.client.MakeRequestWithContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
@@ -146,6 +196,17 @@ func (u UserGroupsService) AddToRelease(productSlug string, releaseID int, userG
 }
 
 func (u UserGroupsService) RemoveFromRelease(productSlug string, releaseID int, userGroupID int) error {
+	return u.RemoveFromReleaseWithContext(context.Background(), productSlug, releaseID, userGroupID)
+}
+
+func (u UserGroupsService) RemoveFromReleaseWithContext(ctx context.Context, productSlug string, releaseID int, userGroupID int) error {
+	ctx, span := u.client.startSpan(ctx, "UserGroups.RemoveFromRelease",
+		tracing.String(tracing.ProductSlug, productSlug),
+		tracing.Int(tracing.ReleaseID, releaseID),
+		tracing.Int(tracing.UserGroupID, userGroupID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf(
 		"/products/%s/releases/%d/remove_user_group",
 		productSlug,
@@ -165,7 +226,8 @@ func (u UserGroupsService) RemoveFromRelease(productSlug string, releaseID int,
 		return err
 	}
 
-	resp, err := u.client.MakeRequest(
+	resp, err := u.client.MakeRequestWithContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusNoContent,
@@ -180,10 +242,18 @@ func (u UserGroupsService) RemoveFromRelease(productSlug string, releaseID int,
 }
 
 func (u UserGroupsService) Get(userGroupID int) (UserGroup, error) {
+	return u.GetWithContext(context.Background(), userGroupID)
+}
+
+func (u UserGroupsService) GetWithContext(ctx context.Context, userGroupID int) (UserGroup, error) {
+	ctx, span := u.client.startSpan(ctx, "UserGroups.Get", tracing.Int(tracing.UserGroupID, userGroupID))
+	defer span.End()
+
 	url := fmt.Sprintf("/user_groups/%d", userGroupID)
 
 	var response UserGroup
-	resp, err := u.client.MakeRequest(
+	resp, err := u.client.MakeRequestWithContext(
+		ctx,
 		"GET",
 		url,
 		http.StatusOK,
@@ -203,6 +273,13 @@ func (u UserGroupsService) Get(userGroupID int) (UserGroup, error) {
 }
 
 func (u UserGroupsService) Create(name string, description string, members []string) (UserGroup, error) {
+	return u.CreateWithContext(context.Background(), name, description, members)
+}
+
+func (u UserGroupsService) CreateWithContext(ctx context.Context, name string, description string, members []string) (UserGroup, error) {
+	ctx, span := u.client.startSpan(ctx, "UserGroups.Create")
+	defer span.End()
+
 	url := "/user_groups"
 
 	if members == nil {
@@ -227,7 +304,8 @@ func (u UserGroupsService) Create(name string, description string, members []str
 	body := bytes.NewReader(b)
 
 	var response UserGroup
-	resp, err := u.client.MakeRequest(
+	resp, err := u.client.MakeRequestWithContext(
+		ctx,
 		"POST",
 		url,
 		http.StatusCreated,
@@ -246,7 +324,58 @@ func (u UserGroupsService) Create(name string, description string, members []str
 	return response, nil
 }
 
+// Ensure makes sure a user group with the given name exists.
+// An existing user group is updated if its description differs.
+// Members are only set when the user group is created; use
+// AddMemberToGroup and RemoveMemberFromGroup to manage them afterwards.
+func (u UserGroupsService) Ensure(name string, description string, members []string) (UserGroup, EnsureAction, error) {
+	return u.EnsureWithContext(context.Background(), name, description, members)
+}
+
+func (u UserGroupsService) EnsureWithContext(ctx context.Context, name string, description string, members []string) (UserGroup, EnsureAction, error) {
+	ctx, span := u.client.startSpan(ctx, "UserGroups.Ensure")
+	defer span.End()
+
+	userGroups, err := u.ListWithContext(ctx)
+	if err != nil {
+		return UserGroup{}, "", err
+	}
+
+	for _, userGroup := range userGroups {
+		if userGroup.Name != name {
+			continue
+		}
+
+		if userGroup.Description == description {
+			return userGroup, EnsureActionUnchanged, nil
+		}
+
+		userGroup.Description = description
+
+		updated, err := u.UpdateWithContext(ctx, userGroup)
+		if err != nil {
+			return UserGroup{}, "", err
+		}
+
+		return updated, EnsureActionUpdated, nil
+	}
+
+	userGroup, err := u.CreateWithContext(ctx, name, description, members)
+	if err != nil {
+		return UserGroup{}, "", err
+	}
+
+	return userGroup, EnsureActionCreated, nil
+}
+
 func (u UserGroupsService) Update(userGroup UserGroup) (UserGroup, error) {
+	return u.UpdateWithContext(context.Background(), userGroup)
+}
+
+func (u UserGroupsService) UpdateWithContext(ctx context.Context, userGroup UserGroup) (UserGroup, error) {
+	ctx, span := u.client.startSpan(ctx, "UserGroups.Update", tracing.Int(tracing.UserGroupID, userGroup.ID))
+	defer span.End()
+
 	url := fmt.Sprintf("/user_groups/%d", userGroup.ID)
 
 	createBody := updateUserGroupBody{
@@ -266,7 +395,8 @@ func (u UserGroupsService) Update(userGroup UserGroup) (UserGroup, error) {
 	body := bytes.NewReader(b)
 
 	var response UpdateUserGroupResponse
-	resp, err := u.client.MakeRequest(
+	resp, err := u.client.MakeRequestWithContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusOK,
@@ -286,9 +416,17 @@ func (u UserGroupsService) Update(userGroup UserGroup) (UserGroup, error) {
 }
 
 func (r UserGroupsService) Delete(userGroupID int) error {
+	return r.DeleteWithContext(context.Background(), userGroupID)
+}
+
+func (r UserGroupsService) DeleteWithContext(ctx context.Context, userGroupID int) error {
+	ctx, span := r.client.startSpan(ctx, "UserGroups.Delete", tracing.Int(tracing.UserGroupID, userGroupID))
+	defer span.End()
+
 	url := fmt.Sprintf("/user_groups/%d", userGroupID)
 
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestWithContext(
+		ctx,
 		"DELETE",
 		url,
 		http.StatusNoContent,
@@ -307,6 +445,20 @@ func (r UserGroupsService) AddMemberToGroup(
 	memberEmailAddress string,
 	admin bool,
 ) (UserGroup, error) {
+	return r.AddMemberToGroupWithContext(context.Background(), userGroupID, memberEmailAddress, admin)
+}
+
+func (r UserGroupsService) AddMemberToGroupWithContext(
+	ctx context.Context,
+	userGroupID int,
+	memberEmailAddress string,
+	admin bool,
+) (UserGroup, error) {
+	ctx, span := r.client.startSpan(ctx, "UserGroups.AddMemberToGroup",
+		tracing.Int(tracing.UserGroupID, userGroupID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf("/user_groups/%d/add_member", userGroupID)
 
 	addRemoveMemberBody := addRemoveMemberBody{
@@ -326,7 +478,8 @@ func (r UserGroupsService) AddMemberToGroup(
 	body := bytes.NewReader(b)
 
 	var response UpdateUserGroupResponse
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestWithContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusOK,
@@ -346,6 +499,15 @@ func (r UserGroupsService) AddMemberToGroup(
 }
 
 func (r UserGroupsService) RemoveMemberFromGroup(userGroupID int, memberEmailAddress string) (UserGroup, error) {
+	return r.RemoveMemberFromGroupWithContext(context.Background(), userGroupID, memberEmailAddress)
+}
+
+func (r UserGroupsService) RemoveMemberFromGroupWithContext(ctx context.Context, userGroupID int, memberEmailAddress string) (UserGroup, error) {
+	ctx, span := r.client.startSpan(ctx, "UserGroups.RemoveMemberFromGroup",
+		tracing.Int(tracing.UserGroupID, userGroupID),
+	)
+	defer span.End()
+
 	url := fmt.Sprintf("/user_groups/%d/remove_member", userGroupID)
 
 	addRemoveMemberBody := addRemoveMemberBody{
@@ -364,7 +526,8 @@ func (r UserGroupsService) RemoveMemberFromGroup(userGroupID int, memberEmailAdd
 	body := bytes.NewReader(b)
 
 	var response UpdateUserGroupResponse
-	resp, err := r.client.MakeRequest(
+	resp, err := r.client.MakeRequestWithContext(
+		ctx,
 		"PATCH",
 		url,
 		http.StatusOK,
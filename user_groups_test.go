@@ -543,6 +543,68 @@ var _ = Describe("PivnetClient - user groups", func() {
 		})
 	})
 
+	Describe("Ensure", func() {
+		var (
+			userGroupsURL string
+		)
+
+		BeforeEach(func() {
+			userGroupsURL = fmt.Sprintf("%s/user_groups", apiPrefix)
+		})
+
+		It("returns an existing user group with the same name and description", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", userGroupsURL),
+					ghttp.RespondWith(http.StatusOK, `{"user_groups":[{"id":1234,"name":"some name","description":"some description"}]}`),
+				),
+			)
+
+			userGroup, action, err := client.UserGroups.Ensure("some name", "some description", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(action).To(Equal(pivnet.EnsureActionUnchanged))
+			Expect(userGroup.ID).To(Equal(1234))
+		})
+
+		It("updates an existing user group whose description differs", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", userGroupsURL),
+					ghttp.RespondWith(http.StatusOK, `{"user_groups":[{"id":1234,"name":"some name","description":"old description"}]}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PATCH", fmt.Sprintf("%s/user_groups/%d", apiPrefix, 1234)),
+					ghttp.VerifyJSON(`{"user_group":{"name":"some name","description":"some description"}}`),
+					ghttp.RespondWith(http.StatusOK, `{"user_group":{"id":1234,"name":"some name","description":"some description"}}`),
+				),
+			)
+
+			userGroup, action, err := client.UserGroups.Ensure("some name", "some description", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(action).To(Equal(pivnet.EnsureActionUpdated))
+			Expect(userGroup.Description).To(Equal("some description"))
+		})
+
+		It("creates the user group when none has the name", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", userGroupsURL),
+					ghttp.RespondWith(http.StatusOK, `{"user_groups":[]}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", userGroupsURL),
+					ghttp.VerifyJSON(`{"user_group":{"name":"some name","description":"some description","members":["some member"]}}`),
+					ghttp.RespondWith(http.StatusCreated, `{"id":2345,"name":"some name"}`),
+				),
+			)
+
+			userGroup, action, err := client.UserGroups.Ensure("some name", "some description", []string{"some member"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(action).To(Equal(pivnet.EnsureActionCreated))
+			Expect(userGroup.ID).To(Equal(2345))
+		})
+	})
+
 	Describe("Update", func() {
 		var (
 			userGroup pivnet.UserGroup
@@ -0,0 +1,88 @@
+package pivnet
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+const dateFormat = "2006-01-02"
+
+var (
+	sha256Regexp = regexp.MustCompile(`^[0-9a-fA-F]{64}$`)
+	md5Regexp    = regexp.MustCompile(`^[0-9a-fA-F]{32}$`)
+)
+
+var fileTypes = []string{
+	FileTypeSoftware,
+	FileTypeDocumentation,
+	FileTypeOpenSourceLicense,
+}
+
+type validator struct {
+	errs      []FieldError
+	lookupErr error
+}
+
+func (v *validator) add(field string, format string, args ...interface{}) {
+	v.errs = append(v.errs, FieldError{
+		Field:   field,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *validator) required(field string, value string) {
+	if value == "" {
+		v.add(field, "must not be empty")
+	}
+}
+
+func (v *validator) date(field string, value string) {
+	if value == "" {
+		return
+	}
+
+	if _, err := time.Parse(dateFormat, value); err != nil {
+		v.add(field, "'%s' is not a valid date, expected format YYYY-MM-DD", value)
+	}
+}
+
+func (v *validator) checksum(field string, value string, re *regexp.Regexp, name string) {
+	if value == "" {
+		return
+	}
+
+	if !re.MatchString(value) {
+		v.add(field, "'%s' is not a valid %s checksum", value, name)
+	}
+}
+
+func (v *validator) oneOf(field string, value string, allowed []string) {
+	if value == "" {
+		return
+	}
+
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+
+	v.add(field, "'%s' is not one of %q", value, allowed)
+}
+
+// lookupFailed records the first failed lookup. Validation carries on so
+// every other invalid field is still reported.
+func (v *validator) lookupFailed(err error) {
+	if v.lookupErr == nil {
+		v.lookupErr = err
+	}
+}
+
+func (v validator) err() error {
+	if len(v.errs) == 0 {
+		return v.lookupErr
+	}
+
+	return ErrValidation{Errors: v.errs, LookupErr: v.lookupErr}
+}